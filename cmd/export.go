@@ -0,0 +1,278 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+)
+
+// runExport serves the server's metrics in the Prometheus text format without starting the TUI
+func runExport(args []string) int {
+	fs := flag.NewFlagSet("export", flag.ExitOnError)
+	conn := addConnectionFlags(fs)
+	listen := fs.String("listen", ":9121", "Address to serve /metrics on")
+	timeout := fs.Duration("scrape-timeout", 5*time.Second, "Maximum time spent querying Redis per scrape")
+	fs.Parse(args)
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	redisCfg, err := conn.apply(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+
+	exporter := &exporter{cfg: &redisCfg, timeout: *timeout}
+	defer exporter.close()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", exporter.serveMetrics)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintln(w, `<html><body><a href="/metrics">Metrics</a></body></html>`)
+	})
+	server := &http.Server{Addr: *listen, Handler: mux}
+
+	// Shut down cleanly on Ctrl+C
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		server.Shutdown(shutdownCtx)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Exporting %s:%d on http://%s/metrics\n", redisCfg.Host, redisCfg.Port, *listen)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		fmt.Fprintf(os.Stderr, "export: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// exporter collects metrics on every scrape, connecting lazily so it keeps serving while Redis is down
+type exporter struct {
+	cfg     *config.RedisConfig
+	timeout time.Duration
+
+	mu     sync.Mutex
+	client *redis.Client
+}
+
+// connect returns the Redis client, creating it if needed
+func (e *exporter) connect() (*redis.Client, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.client == nil {
+		client, err := redis.New(e.cfg)
+		if err != nil {
+			return nil, err
+		}
+		e.client = client
+	}
+	return e.client, nil
+}
+
+// close closes the Redis client
+func (e *exporter) close() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.client != nil {
+		e.client.Close()
+	}
+}
+
+// serveMetrics handles a scrape
+func (e *exporter) serveMetrics(w http.ResponseWriter, r *http.Request) {
+	ctx, cancel := context.WithTimeout(r.Context(), e.timeout)
+	defer cancel()
+
+	start := time.Now()
+	var buf bytes.Buffer
+	p := &promWriter{w: &buf}
+
+	up := 1.0
+	if err := e.collect(ctx, p); err != nil {
+		fmt.Fprintf(os.Stderr, "export: scrape failed: %v\n", err)
+		up = 0
+	}
+	p.gauge("redis_up", "Whether the last scrape of Redis succeeded", up)
+	p.gauge("redis_exporter_scrape_duration_seconds", "Time spent collecting metrics", time.Since(start).Seconds())
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(buf.Bytes())
+}
+
+// collect writes every metric family, stopping at the first failed query
+func (e *exporter) collect(ctx context.Context, p *promWriter) error {
+	client, err := e.connect()
+	if err != nil {
+		return err
+	}
+	client = client.WithContext(ctx)
+
+	m, err := client.GetMetrics()
+	if err != nil {
+		return err
+	}
+	p.gauge("redis_connected_clients", "Number of client connections", float64(m.ConnectedClients))
+	p.gauge("redis_memory_used_bytes", "Memory allocated by Redis", float64(m.UsedMemory))
+	p.gauge("redis_memory_used_rss_bytes", "Memory allocated as seen by the operating system", float64(m.UsedMemoryRss))
+	p.gauge("redis_memory_max_bytes", "The maxmemory setting, 0 when unlimited", float64(m.MaxMemory))
+	p.gauge("redis_mem_fragmentation_ratio", "Ratio of RSS to used memory", m.MemFragmentationRatio)
+	p.gauge("redis_instantaneous_ops_per_sec", "Commands per second sampled by the server", float64(m.InstantaneousOpsPerSec))
+	p.gauge("redis_uptime_in_seconds", "Seconds since the server started", float64(m.UptimeInSeconds))
+	p.counter("redis_commands_processed_total", "Commands processed by the server", float64(m.TotalCommandsProcessed))
+	p.counter("redis_keyspace_hits_total", "Successful key lookups", float64(m.KeyspaceHits))
+	p.counter("redis_keyspace_misses_total", "Failed key lookups", float64(m.KeyspaceMisses))
+	p.counter("redis_net_input_bytes_total", "Bytes read from the network", float64(m.TotalNetInputBytes))
+	p.counter("redis_net_output_bytes_total", "Bytes written to the network", float64(m.TotalNetOutputBytes))
+	p.counter("redis_expired_keys_total", "Keys removed because their TTL expired", float64(m.ExpiredKeys))
+	p.counter("redis_evicted_keys_total", "Keys evicted because of maxmemory", float64(m.EvictedKeys))
+
+	keyspace, err := client.GetKeyspaceStats()
+	if err != nil {
+		return err
+	}
+	p.family("redis_db_keys", "gauge", "Keys per database")
+	for _, db := range keyspace {
+		p.sample("redis_db_keys", float64(db.Keys), "db", "db"+strconv.Itoa(db.DB))
+	}
+	p.family("redis_db_keys_expiring", "gauge", "Keys with a TTL per database")
+	for _, db := range keyspace {
+		p.sample("redis_db_keys_expiring", float64(db.Expires), "db", "db"+strconv.Itoa(db.DB))
+	}
+	p.family("redis_db_avg_ttl_seconds", "gauge", "Average TTL of keys with a TTL per database")
+	for _, db := range keyspace {
+		p.sample("redis_db_avg_ttl_seconds", float64(db.AvgTTL)/1000, "db", "db"+strconv.Itoa(db.DB))
+	}
+
+	commands, err := client.GetCommandStats()
+	if err != nil {
+		return err
+	}
+	writeCommandMetrics(p, commands)
+
+	// Older servers have no errorstats section
+	if errorStats, err := client.GetErrorStats(); err == nil {
+		p.family("redis_errors_total", "counter", "Error replies per error type")
+		for _, e := range errorStats {
+			p.sample("redis_errors_total", float64(e.Count), "err", e.Type)
+		}
+	}
+
+	clients, err := client.GetClientList()
+	if err != nil {
+		return err
+	}
+	type clientKey struct{ user, lib string }
+	counts := make(map[clientKey]int)
+	var keys []clientKey
+	for _, c := range clients {
+		k := clientKey{c.User, c.LibName}
+		if counts[k] == 0 {
+			keys = append(keys, k)
+		}
+		counts[k]++
+	}
+	p.family("redis_client_connections", "gauge", "Connected clients per user and client library")
+	for _, k := range keys {
+		p.sample("redis_client_connections", float64(counts[k]), "user", k.user, "lib", k.lib)
+	}
+
+	return ctx.Err()
+}
+
+// writeCommandMetrics writes the per-command families of INFO commandstats and latencystats;
+// latency percentiles form a summary in seconds whose sum and count are the command's totals
+func writeCommandMetrics(p *promWriter, commands []redis.CommandStat) {
+	p.family("redis_commands_total", "counter", "Calls per command")
+	for _, c := range commands {
+		p.sample("redis_commands_total", float64(c.Calls), "cmd", c.Command)
+	}
+	p.family("redis_commands_duration_seconds_total", "counter", "Time spent per command")
+	for _, c := range commands {
+		p.sample("redis_commands_duration_seconds_total", c.TotalDuration/1000, "cmd", c.Command)
+	}
+	p.family("redis_commands_rejected_calls_total", "counter", "Rejected calls per command")
+	for _, c := range commands {
+		p.sample("redis_commands_rejected_calls_total", float64(c.RejectedCalls), "cmd", c.Command)
+	}
+	p.family("redis_commands_failed_calls_total", "counter", "Failed calls per command")
+	for _, c := range commands {
+		p.sample("redis_commands_failed_calls_total", float64(c.FailedCalls), "cmd", c.Command)
+	}
+	p.family("redis_commands_latency_seconds", "summary", "Latency percentiles per command from INFO latencystats")
+	for _, c := range commands {
+		if !c.HasPercentiles {
+			continue
+		}
+		p.sample("redis_commands_latency_seconds", c.P50/1e6, "cmd", c.Command, "quantile", "0.5")
+		p.sample("redis_commands_latency_seconds", c.P99/1e6, "cmd", c.Command, "quantile", "0.99")
+		p.sample("redis_commands_latency_seconds", c.P999/1e6, "cmd", c.Command, "quantile", "0.999")
+		p.sample("redis_commands_latency_seconds_sum", c.TotalDuration/1000, "cmd", c.Command)
+		p.sample("redis_commands_latency_seconds_count", float64(c.Calls), "cmd", c.Command)
+	}
+}
+
+// promWriter writes metrics in the Prometheus text exposition format
+type promWriter struct {
+	w      io.Writer
+	header string // HELP and TYPE lines of the current family, written with its first sample
+}
+
+// family starts a metric; its HELP and TYPE lines are left out when it gets no samples
+func (p *promWriter) family(name, kind, help string) {
+	p.header = fmt.Sprintf("# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+}
+
+// sample writes one sample; labels are name/value pairs
+func (p *promWriter) sample(name string, value float64, labels ...string) {
+	fmt.Fprint(p.w, p.header)
+	p.header = ""
+	fmt.Fprint(p.w, name)
+	if len(labels) > 0 {
+		pairs := make([]string, 0, len(labels)/2)
+		for i := 0; i+1 < len(labels); i += 2 {
+			pairs = append(pairs, labels[i]+`="`+labelEscaper.Replace(labels[i+1])+`"`)
+		}
+		fmt.Fprintf(p.w, "{%s}", strings.Join(pairs, ","))
+	}
+	fmt.Fprintf(p.w, " %s\n", strconv.FormatFloat(value, 'g', -1, 64))
+}
+
+// gauge writes a single-sample gauge
+func (p *promWriter) gauge(name, help string, value float64) {
+	p.family(name, "gauge", help)
+	p.sample(name, value)
+}
+
+// counter writes a single-sample counter
+func (p *promWriter) counter(name, help string, value float64) {
+	p.family(name, "counter", help)
+	p.sample(name, value)
+}
+
+// labelEscaper escapes label values as the text format requires
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
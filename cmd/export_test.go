@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPromWriter tests the text format, label escaping and leaving out empty families
+func TestPromWriter(t *testing.T) {
+	var buf bytes.Buffer
+	p := &promWriter{w: &buf}
+
+	p.gauge("redis_up", "Whether the last scrape of Redis succeeded", 1)
+	p.family("redis_errors_total", "counter", "Error replies per error type")
+	p.family("redis_client_connections", "gauge", "Connected clients per user and client library")
+	p.sample("redis_client_connections", 2, "user", "default", "lib", `go-redis("x\y")`)
+	p.counter("redis_keyspace_hits_total", "Successful key lookups", 1.5e9)
+
+	assert.Equal(t, `# HELP redis_up Whether the last scrape of Redis succeeded
+# TYPE redis_up gauge
+redis_up 1
+# HELP redis_client_connections Connected clients per user and client library
+# TYPE redis_client_connections gauge
+redis_client_connections{user="default",lib="go-redis(\"x\\y\")"} 2
+# HELP redis_keyspace_hits_total Successful key lookups
+# TYPE redis_keyspace_hits_total counter
+redis_keyspace_hits_total 1.5e+09
+`, buf.String())
+}
+
+// TestWriteCommandMetrics tests the per-command families and the latency summary
+func TestWriteCommandMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	writeCommandMetrics(&promWriter{w: &buf}, []redis.CommandStat{
+		{Command: "get", Calls: 10, TotalDuration: 1.5, FailedCalls: 1, HasPercentiles: true, P50: 2, P99: 4, P999: 8},
+		{Command: "config|set", Calls: 1, TotalDuration: 0.25},
+	})
+
+	assert.Equal(t, `# HELP redis_commands_total Calls per command
+# TYPE redis_commands_total counter
+redis_commands_total{cmd="get"} 10
+redis_commands_total{cmd="config|set"} 1
+# HELP redis_commands_duration_seconds_total Time spent per command
+# TYPE redis_commands_duration_seconds_total counter
+redis_commands_duration_seconds_total{cmd="get"} 0.0015
+redis_commands_duration_seconds_total{cmd="config|set"} 0.00025
+# HELP redis_commands_rejected_calls_total Rejected calls per command
+# TYPE redis_commands_rejected_calls_total counter
+redis_commands_rejected_calls_total{cmd="get"} 0
+redis_commands_rejected_calls_total{cmd="config|set"} 0
+# HELP redis_commands_failed_calls_total Failed calls per command
+# TYPE redis_commands_failed_calls_total counter
+redis_commands_failed_calls_total{cmd="get"} 1
+redis_commands_failed_calls_total{cmd="config|set"} 0
+# HELP redis_commands_latency_seconds Latency percentiles per command from INFO latencystats
+# TYPE redis_commands_latency_seconds summary
+redis_commands_latency_seconds{cmd="get",quantile="0.5"} 2e-06
+redis_commands_latency_seconds{cmd="get",quantile="0.99"} 4e-06
+redis_commands_latency_seconds{cmd="get",quantile="0.999"} 8e-06
+redis_commands_latency_seconds_sum{cmd="get"} 0.0015
+redis_commands_latency_seconds_count{cmd="get"} 10
+`, buf.String())
+
+	// Without latencystats, as on servers before Redis 7, the summary is left out
+	buf.Reset()
+	writeCommandMetrics(&promWriter{w: &buf}, []redis.CommandStat{{Command: "get", Calls: 1}})
+	assert.NotContains(t, buf.String(), "latency")
+
+	buf.Reset()
+	writeCommandMetrics(&promWriter{w: &buf}, nil)
+	assert.Empty(t, buf.String())
+}
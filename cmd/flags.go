@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+)
+
+// connectionFlags holds the flags that select and override a Redis connection
+type connectionFlags struct {
+	profile  *string
+	host     *string
+	port     *int
+	password *string
+	db       *int
+	resp2    *bool
+	resp3    *bool
+	readOnly *bool
+}
+
+// addConnectionFlags registers the connection flags on a flag set
+func addConnectionFlags(fs *flag.FlagSet) *connectionFlags {
+	return &connectionFlags{
+		profile:  fs.String("profile", "", "Connection profile from the config file"),
+		host:     fs.String("host", "", "Redis host"),
+		port:     fs.Int("port", 0, "Redis port"),
+		password: fs.String("password", "", "Redis password"),
+		db:       fs.Int("db", -1, "Redis database number"),
+		resp2:    fs.Bool("resp2", false, "Use the RESP2 protocol instead of RESP3"),
+		resp3:    fs.Bool("resp3", false, "Use the RESP3 protocol even if the config selects RESP2"),
+		readOnly: fs.Bool("readonly", false, "Refuse commands that write, on every connection"),
+	}
+}
+
+// apply returns the requested profile with any flags given applied. cfg is left as loaded, so
+// the overrides never end up in the config file when it is saved.
+func (f *connectionFlags) apply(cfg *config.Config) (config.RedisConfig, error) {
+	redisCfg := cfg.Redis
+	if *f.profile != "" {
+		profile, err := cfg.Profile(*f.profile)
+		if err != nil {
+			return config.RedisConfig{}, err
+		}
+		redisCfg = *profile
+	}
+
+	if *f.host != "" {
+		redisCfg.Host = *f.host
+	}
+	if *f.port != 0 {
+		redisCfg.Port = *f.port
+	}
+	if *f.password != "" {
+		redisCfg.Password = *f.password
+	}
+	if *f.db != -1 {
+		redisCfg.DB = *f.db
+	}
+	if *f.resp2 && *f.resp3 {
+		return config.RedisConfig{}, fmt.Errorf("-resp2 and -resp3 cannot be used together")
+	}
+	if *f.resp2 {
+		redisCfg.Protocol = 2
+	}
+	if *f.resp3 {
+		redisCfg.Protocol = 3
+	}
+	if *f.readOnly || cfg.ReadOnly {
+		redisCfg.ReadOnly = true
+	}
+	return redisCfg, nil
+}
@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestConnectionFlagsApply tests that flags override the selected profile without changing the config
+func TestConnectionFlagsApply(t *testing.T) {
+	cfg := config.Default()
+	cfg.Profiles = map[string]config.RedisConfig{"prod": {Host: "prod.internal", Port: 6380}}
+	saved := cfg.Redis
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	conn := addConnectionFlags(fs)
+	if !assert.NoError(t, fs.Parse([]string{"-profile", "prod", "-db", "3", "-resp2", "-readonly"})) {
+		return
+	}
+
+	redisCfg, err := conn.apply(cfg)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod.internal", redisCfg.Host)
+	assert.Equal(t, 6380, redisCfg.Port)
+	assert.Equal(t, 3, redisCfg.DB)
+	assert.Equal(t, 2, redisCfg.Protocol)
+	assert.True(t, redisCfg.ReadOnly)
+
+	// Nothing reaches the config, so saving it keeps the file's settings
+	assert.Equal(t, saved, cfg.Redis)
+	assert.False(t, cfg.ReadOnly)
+
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	conn = addConnectionFlags(fs)
+	fs.Parse([]string{"-resp2", "-resp3"})
+	_, err = conn.apply(cfg)
+	assert.Error(t, err)
+}
@@ -18,17 +18,32 @@ var (
 	GitCommit = "unknown"
 )
 
+// subcommands run without the TUI; each returns the process exit code
+var subcommands = map[string]func(args []string) int{
+	"migrate": runMigrate,
+	"export":  runExport,
+	"keys":    runKeys,
+	"get":     runGet,
+	"info":    runInfo,
+	"clients": runClients,
+	"slowlog": runSlowlog,
+	"bigkeys": runBigkeys,
+}
+
 // Main is the main entry point
 func Main() {
+	if len(os.Args) > 1 {
+		if run, ok := subcommands[os.Args[1]]; ok {
+			os.Exit(run(os.Args[2:]))
+		}
+	}
+
 	var (
-		host     = flag.String("host", "", "Redis host")
-		port     = flag.Int("port", 0, "Redis port")
-		password = flag.String("password", "", "Redis password")
-		db       = flag.Int("db", -1, "Redis database number")
-		verbose  = flag.Int("v", 0, "Verbosity level (0=ERROR, 1=WARN, 2=INFO, 3=DEBUG, 4=TRACE)")
-		console  = flag.Bool("console", false, "Enable console logging (logs will appear in stderr)")
-		help     = flag.Bool("help", false, "Show help")
-		version  = flag.Bool("version", false, "Show version information")
+		conn    = addConnectionFlags(flag.CommandLine)
+		verbose = flag.Int("v", 0, "Verbosity level (0=ERROR, 1=WARN, 2=INFO, 3=DEBUG, 4=TRACE)")
+		console = flag.Bool("console", false, "Enable console logging (logs will appear in stderr)")
+		help    = flag.Bool("help", false, "Show help")
+		version = flag.Bool("version", false, "Show version information")
 	)
 	flag.Parse()
 
@@ -75,21 +90,13 @@ func Main() {
 	}
 
 	// Override with command line flags
-	if *host != "" {
-		cfg.Redis.Host = *host
-	}
-	if *port != 0 {
-		cfg.Redis.Port = *port
-	}
-	if *password != "" {
-		cfg.Redis.Password = *password
-	}
-	if *db != -1 {
-		cfg.Redis.DB = *db
+	redisCfg, err := conn.apply(cfg)
+	if err != nil {
+		log.Fatalf("Failed to select connection: %v", err)
 	}
 
 	// Create and run the application
-	app := ui.NewApp(cfg)
+	app := ui.NewApp(cfg, redisCfg, *conn.readOnly)
 	if err := app.Run(); err != nil {
 		log.Fatalf("Application error: %v", err)
 	}
@@ -105,8 +112,26 @@ func showHelp() {
 	fmt.Print(`redis-valkey-tui - A k9s-inspired TUI client for Redis/Valkey
 
 Usage: redis-valkey-tui [options]
+       redis-valkey-tui <subcommand> [options]
+
+Subcommands:
+  migrate     Copy keys to another connection profile (DUMP/RESTORE)
+              -to profile -match pattern [-batch n] [-rate keys/s] [-replace] [-checkpoint file]
+  export      Serve server metrics for Prometheus on /metrics
+              [-listen addr] [-scrape-timeout duration]
+  keys        List keys: -match pattern [-type t] [-limit n] [-l]
+  get         Print a key's value: get <key>
+  info        Print INFO fields: info [section]
+  clients     Print CLIENT LIST
+  slowlog     Print the slow log: [-count n]
+  bigkeys     Find the largest keys per type: [-match pattern] [-top n] [-batch n]
+
+  Query subcommands accept the connection options and -o table|json|ndjson, and exit with
+  1 on errors (including a missing key for get) and 2 on usage errors.
 
 Options:
+  -profile string
+        Connection profile from the config file
   -host string
         Redis host (default: localhost)
   -port int
@@ -115,6 +140,10 @@ Options:
         Redis password
   -db int
         Redis database number (default: 0)
+  -resp2
+        Use the RESP2 protocol instead of RESP3
+  -resp3
+        Use the RESP3 protocol even if the config selects RESP2 (the default)
   -v int
         Verbosity level: 0=ERROR, 1=WARN, 2=INFO, 3=DEBUG, 4=TRACE (default: 2)
   -console
@@ -160,6 +189,10 @@ Examples:
   redis-valkey-tui -host redis.example.com -port 6380  # Connect to remote server
   redis-valkey-tui -password mypassword -db 1 -v 3     # Connect with auth, DB selection, and DEBUG logging
   redis-valkey-tui --version                           # Show version information
+  redis-valkey-tui migrate -profile prod -to staging -match 'user:*' -checkpoint user.ckpt
+  redis-valkey-tui export -profile prod -listen :9121 -scrape-timeout 3s
+  redis-valkey-tui keys -profile prod -match 'session:*' -o ndjson
+  redis-valkey-tui info memory -o json
 
 For debugging issues, use: redis-valkey-tui -v 4 -console
 
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+)
+
+// runMigrate copies keys from the selected connection to a target profile without starting the TUI
+func runMigrate(args []string) int {
+	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	conn := addConnectionFlags(fs)
+	to := fs.String("to", "", "Target connection profile (required)")
+	match := fs.String("match", "*", "SCAN pattern of keys to copy")
+	batch := fs.Int("batch", 100, "Keys per pipelined batch")
+	rate := fs.Int("rate", 0, "Maximum keys per second (0 = unlimited)")
+	replace := fs.Bool("replace", false, "Overwrite keys that already exist on the target")
+	checkpoint := fs.String("checkpoint", "", "Checkpoint file used to resume an interrupted migration")
+	fs.Parse(args)
+
+	if *to == "" {
+		fmt.Fprintln(os.Stderr, "migrate: -to <profile> is required")
+		fs.Usage()
+		return 2
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+	sourceCfg, err := conn.apply(cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+	targetCfg, err := cfg.Profile(*to)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		return 1
+	}
+	if *conn.readOnly {
+		targetCfg.ReadOnly = true
+	}
+
+	source, err := redis.New(&sourceCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: source: %v\n", err)
+		return 1
+	}
+	defer source.Close()
+
+	target, err := redis.New(targetCfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: target: %v\n", err)
+		return 1
+	}
+	defer target.Close()
+
+	// Stop cleanly on Ctrl+C so the checkpoint reflects the last finished batch
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Fprintf(os.Stderr, "Migrating %q from %s:%d/%d to profile %s\n",
+		*match, sourceCfg.Host, sourceCfg.Port, sourceCfg.DB, *to)
+
+	progress, err := source.Migrate(ctx, target, redis.MigrateOptions{
+		Pattern:    *match,
+		BatchSize:  *batch,
+		Replace:    *replace,
+		RateLimit:  *rate,
+		Checkpoint: *checkpoint,
+		TargetName: *to,
+		Progress: func(p redis.MigrateProgress) {
+			fmt.Fprintf(os.Stderr, "\r%s", p)
+		},
+	})
+	fmt.Fprintln(os.Stderr)
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "migrate: %v\n", err)
+		if *checkpoint != "" {
+			fmt.Fprintf(os.Stderr, "Re-run with -checkpoint %s to resume\n", *checkpoint)
+		}
+		return 1
+	}
+
+	fmt.Printf("Done: %s\n", progress)
+	if progress.Failed > 0 {
+		return 1
+	}
+	return 0
+}
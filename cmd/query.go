@@ -0,0 +1,404 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+)
+
+// Output formats of the query subcommands
+const (
+	outputTable  = "table"
+	outputJSON   = "json"
+	outputNDJSON = "ndjson"
+)
+
+// queryFlags holds the flags shared by the query subcommands
+type queryFlags struct {
+	name   string
+	fs     *flag.FlagSet
+	conn   *connectionFlags
+	format *string
+}
+
+// newQueryFlags creates the flag set of a query subcommand
+func newQueryFlags(name string) *queryFlags {
+	fs := flag.NewFlagSet(name, flag.ExitOnError)
+	return &queryFlags{
+		name:   name,
+		fs:     fs,
+		conn:   addConnectionFlags(fs),
+		format: fs.String("o", outputTable, "Output format: table, json or ndjson"),
+	}
+}
+
+// parse parses args and checks the output format
+func (q *queryFlags) parse(args []string) error {
+	q.fs.Parse(args)
+	switch *q.format {
+	case outputTable, outputJSON, outputNDJSON:
+		return nil
+	}
+	return fmt.Errorf("unknown output format %q (use table, json or ndjson)", *q.format)
+}
+
+// connect loads the configuration and connects to the selected server
+func (q *queryFlags) connect() (*redis.Client, error) {
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, err
+	}
+	redisCfg, err := q.conn.apply(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return redis.New(&redisCfg)
+}
+
+// fail prints an error and returns the exit code for it
+func (q *queryFlags) fail(err error) int {
+	fmt.Fprintf(os.Stderr, "%s: %v\n", q.name, err)
+	return 1
+}
+
+// usage prints a usage error and returns the exit code for it
+func (q *queryFlags) usage(msg string) int {
+	fmt.Fprintf(os.Stderr, "%s: %s\n", q.name, msg)
+	q.fs.Usage()
+	return 2
+}
+
+// output collects rows for the table format and records for the JSON formats
+type output struct {
+	headers []string
+	rows    [][]string
+	records []interface{}
+}
+
+// add appends a record and its table row
+func (o *output) add(record interface{}, cells ...string) {
+	o.records = append(o.records, record)
+	o.rows = append(o.rows, cells)
+}
+
+// write prints the output in the given format
+func (o *output) write(w io.Writer, format string) error {
+	switch format {
+	case outputJSON:
+		records := o.records
+		if records == nil {
+			records = []interface{}{}
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(records)
+	case outputNDJSON:
+		enc := json.NewEncoder(w)
+		for _, r := range o.records {
+			if err := enc.Encode(r); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	if len(o.headers) > 0 {
+		fmt.Fprintln(tw, strings.Join(o.headers, "\t"))
+	}
+	for _, row := range o.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+// runKeys lists keys matching a pattern
+func runKeys(args []string) int {
+	q := newQueryFlags("keys")
+	match := q.fs.String("match", "*", "SCAN pattern")
+	keyType := q.fs.String("type", "", "Only keys of this type (string, list, set, hash, zset, stream)")
+	limit := q.fs.Int("limit", 0, "Stop after this many keys (0 = no limit)")
+	long := q.fs.Bool("l", false, "Include type, TTL and memory usage")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	out := &output{headers: []string{"KEY"}}
+	if *long {
+		out.headers = []string{"KEY", "TYPE", "TTL", "MEMORY"}
+	}
+
+	count := 0
+	err = client.ScanKeys(ctx, *match, *keyType, func(keys []string) error {
+		for _, key := range keys {
+			if *limit > 0 && count >= *limit {
+				return errStopScan
+			}
+			count++
+
+			if !*long {
+				out.add(map[string]string{"key": key}, key)
+				continue
+			}
+			info, err := client.GetKeyInfo(key)
+			if err != nil {
+				return err
+			}
+			ttl := int64(-1)
+			if info.TTL > 0 {
+				ttl = int64(info.TTL.Seconds())
+			}
+			out.add(map[string]interface{}{"key": key, "type": info.Type, "ttl": ttl, "memory": info.MemoryUsage},
+				key, info.Type, strconv.FormatInt(ttl, 10), strconv.FormatInt(info.MemoryUsage, 10))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errStopScan) {
+		return q.fail(err)
+	}
+
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+	return 0
+}
+
+// errStopScan ends a key scan early without an error
+var errStopScan = errors.New("stop scan")
+
+// runGet prints the value of a key
+func runGet(args []string) int {
+	q := newQueryFlags("get")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+	if q.fs.NArg() != 1 {
+		return q.usage("expected exactly one key")
+	}
+	key := q.fs.Arg(0)
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	keyType, value, err := client.ReadValue(key)
+	if err != nil {
+		return q.fail(err)
+	}
+	ttl, err := client.TTL(key)
+	if err != nil {
+		return q.fail(err)
+	}
+
+	if *q.format == outputTable {
+		// Strings print as they are so the value can be piped; other types as JSON
+		if s, ok := value.(redis.Blob); ok {
+			fmt.Println(string(s))
+			return 0
+		}
+		data, err := json.MarshalIndent(value, "", "  ")
+		if err != nil {
+			return q.fail(err)
+		}
+		fmt.Println(string(data))
+		return 0
+	}
+
+	out := &output{}
+	out.add(map[string]interface{}{"key": key, "type": keyType, "ttl": ttl, "value": value})
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+	return 0
+}
+
+// runInfo prints INFO fields
+func runInfo(args []string) int {
+	q := newQueryFlags("info")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+	if q.fs.NArg() > 1 {
+		return q.usage("expected at most one section")
+	}
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	fields, err := client.InfoFields(q.fs.Arg(0))
+	if err != nil {
+		return q.fail(err)
+	}
+	if len(fields) == 0 && q.fs.NArg() == 1 {
+		return q.fail(fmt.Errorf("unknown INFO section: %s", q.fs.Arg(0)))
+	}
+
+	out := &output{headers: []string{"SECTION", "FIELD", "VALUE"}}
+	for _, f := range fields {
+		out.add(f, f.Section, f.Field, f.Value)
+	}
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+	return 0
+}
+
+// runClients prints CLIENT LIST
+func runClients(args []string) int {
+	q := newQueryFlags("clients")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	clients, err := client.GetClientList()
+	if err != nil {
+		return q.fail(err)
+	}
+
+	out := &output{headers: []string{"ID", "ADDR", "NAME", "USER", "DB", "AGE", "IDLE", "CMD", "LIB", "TOT-MEM"}}
+	for _, c := range clients {
+		lib := strings.TrimSpace(c.LibName + " " + c.LibVersion)
+		out.add(c.Fields, c.ID, c.Address, c.Name, c.User, strconv.Itoa(c.DB),
+			(time.Duration(c.Age) * time.Second).String(), (time.Duration(c.Idle) * time.Second).String(),
+			c.LastCommand, lib, strconv.FormatInt(c.TotalMem, 10))
+	}
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+	return 0
+}
+
+// slowLogRecord is the JSON form of a slow log entry
+type slowLogRecord struct {
+	ID         int64     `json:"id"`
+	Time       time.Time `json:"time"`
+	DurationUS int64     `json:"duration_us"`
+	Args       []string  `json:"args"`
+	ClientAddr string    `json:"client_addr"`
+	ClientName string    `json:"client_name"`
+}
+
+// runSlowlog prints SLOWLOG GET
+func runSlowlog(args []string) int {
+	q := newQueryFlags("slowlog")
+	count := q.fs.Int64("count", 128, "Number of entries")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	entries, err := client.GetSlowLog(*count)
+	if err != nil {
+		return q.fail(err)
+	}
+
+	out := &output{headers: []string{"ID", "TIME", "DURATION", "COMMAND", "CLIENT", "NAME"}}
+	for _, e := range entries {
+		out.add(slowLogRecord{
+			ID:         e.ID,
+			Time:       e.Time,
+			DurationUS: e.Duration.Microseconds(),
+			Args:       e.Args,
+			ClientAddr: e.ClientAddr,
+			ClientName: e.ClientName,
+		}, strconv.FormatInt(e.ID, 10), e.Time.Format(time.RFC3339), e.Duration.String(),
+			redis.QuoteArgs(e.Args), e.ClientAddr, e.ClientName)
+	}
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+	return 0
+}
+
+// runBigkeys scans the keyspace for the largest keys per type
+func runBigkeys(args []string) int {
+	q := newQueryFlags("bigkeys")
+	match := q.fs.String("match", "*", "SCAN pattern")
+	top := q.fs.Int("top", 10, "Keys reported per type")
+	batch := q.fs.Int("batch", 100, "Keys per SCAN and pipelined round trip")
+	if err := q.parse(args); err != nil {
+		return q.usage(err.Error())
+	}
+
+	client, err := q.connect()
+	if err != nil {
+		return q.fail(err)
+	}
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	result, err := client.BigKeys(ctx, redis.BigKeysOptions{
+		Pattern:   *match,
+		BatchSize: *batch,
+		Top:       *top,
+		Progress: func(scanned int64) {
+			fmt.Fprintf(os.Stderr, "\rScanned %d keys", scanned)
+		},
+	})
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return q.fail(err)
+	}
+
+	out := &output{headers: []string{"TYPE", "KEY", "LENGTH", "MEMORY"}}
+	for _, k := range result.Keys {
+		out.add(k, k.Type, k.Key, strconv.FormatInt(k.Length, 10), strconv.FormatInt(k.Memory, 10))
+	}
+	if err := out.write(os.Stdout, *q.format); err != nil {
+		return q.fail(err)
+	}
+
+	if *q.format == outputTable {
+		fmt.Fprintf(os.Stderr, "%d keys scanned", result.Scanned)
+		types := make([]string, 0, len(result.Counts))
+		for t := range result.Counts {
+			types = append(types, t)
+		}
+		sort.Strings(types)
+		for _, t := range types {
+			fmt.Fprintf(os.Stderr, ", %d %s", result.Counts[t], t)
+		}
+		fmt.Fprintln(os.Stderr)
+	}
+	return 0
+}
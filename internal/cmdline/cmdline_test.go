@@ -0,0 +1,68 @@
+package cmdline
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestSplit tests splitting command lines into arguments
+func TestSplit(t *testing.T) {
+	tests := []struct {
+		line string
+		args []string
+	}{
+		{"", nil},
+		{"   ", nil},
+		{"PING", []string{"PING"}},
+		{"  SET  k   v  ", []string{"SET", "k", "v"}},
+		{"SET k\tv", []string{"SET", "k", "v"}},
+		{`SET k "hello world"`, []string{"SET", "k", "hello world"}},
+		{`SET k 'hello world'`, []string{"SET", "k", "hello world"}},
+		{`SET k ""`, []string{"SET", "k", ""}},
+		{`SET k ''`, []string{"SET", "k", ""}},
+		{`"" "" x`, []string{"", "", "x"}},
+		{`SET k "a\nb\tc\rd"`, []string{"SET", "k", "a\nb\tc\rd"}},
+		{`SET k "\a\b"`, []string{"SET", "k", "\a\b"}},
+		{`SET k "\x00\xff\x41"`, []string{"SET", "k", "\x00\xff\x41"}},
+		{`SET k "\xZZ"`, []string{"SET", "k", "xZZ"}},
+		{`SET k "\x4"`, []string{"SET", "k", "x4"}},
+		{`SET k "say \"hi\""`, []string{"SET", "k", `say "hi"`}},
+		{`SET k "back\\slash"`, []string{"SET", "k", `back\slash`}},
+		{`SET k 'it\'s'`, []string{"SET", "k", "it's"}},
+		{`SET k 'no\nescape'`, []string{"SET", "k", `no\nescape`}},
+		{`SET k a\nb`, []string{"SET", "k", `a\nb`}},
+		{`SET k "it's"`, []string{"SET", "k", "it's"}},
+		{`SET k 'say "hi"'`, []string{"SET", "k", `say "hi"`}},
+		{`SET k pre"fix and"`, []string{"SET", "k", "prefix and"}},
+		{"SET k \"multi\nline\"", []string{"SET", "k", "multi\nline"}},
+		{`SET "key with space"	"v"`, []string{"SET", "key with space", "v"}},
+		{"SET k 日本語", []string{"SET", "k", "日本語"}},
+	}
+
+	for _, tt := range tests {
+		args, err := Split(tt.line)
+		if assert.NoError(t, err, tt.line) {
+			assert.Equal(t, tt.args, args, tt.line)
+		}
+	}
+}
+
+// TestSplitErrors tests that malformed quoting is rejected
+func TestSplitErrors(t *testing.T) {
+	tests := []struct {
+		line string
+		err  error
+	}{
+		{`SET k "unterminated`, ErrUnbalancedQuotes},
+		{`SET k 'unterminated`, ErrUnbalancedQuotes},
+		{`SET k "ends with backslash\"`, ErrUnbalancedQuotes},
+		{`SET k "a"b`, ErrTrailingText},
+		{`SET k 'a'b`, ErrTrailingText},
+	}
+
+	for _, tt := range tests {
+		_, err := Split(tt.line)
+		assert.ErrorIs(t, err, tt.err, tt.line)
+	}
+}
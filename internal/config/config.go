@@ -5,23 +5,43 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 )
 
 // Config holds the application configuration
 type Config struct {
-	Redis RedisConfig `json:"redis"`
-	UI    UIConfig    `json:"ui"`
+	Redis    RedisConfig            `json:"redis"`
+	Profiles map[string]RedisConfig `json:"profiles,omitempty"`
+	UI       UIConfig               `json:"ui"`
+	Alerts   AlertsConfig           `json:"alerts"`
+	ReadOnly bool                   `json:"readonly,omitempty"` // Every connection is read-only, whatever its profile says
 }
 
 // RedisConfig holds Redis connection configuration
 type RedisConfig struct {
-	Host     string    `json:"host"`
-	Port     int       `json:"port"`
-	Password string    `json:"password"`
-	DB       int       `json:"db"`
-	Timeout  int       `json:"timeout"`
-	PoolSize int       `json:"pool_size"`
-	TLS      TLSConfig `json:"tls"`
+	Name     string      `json:"-"` // Profile name, set when loaded from Profiles
+	Host     string      `json:"host"`
+	Port     int         `json:"port"`
+	Password string      `json:"password"`
+	DB       int         `json:"db"`
+	Timeout  int         `json:"timeout"`
+	PoolSize int         `json:"pool_size"`
+	Protocol int         `json:"protocol"` // RESP version: 3 (HELLO 3, the default) or 2
+	TLS      TLSConfig   `json:"tls"`
+	Guard    GuardConfig `json:"guard"`
+	ReadOnly bool        `json:"readonly,omitempty"` // Refuse commands that write
+}
+
+// GuardConfig adjusts which commands ask for typed confirmation on a connection. Names are
+// commands or subcommands such as "FLUSHDB" or "CONFIG SET" and extend the built-in lists.
+type GuardConfig struct {
+	Disabled    bool     `json:"disabled,omitempty"`
+	Destructive []string `json:"destructive,omitempty"`
+	Blocking    []string `json:"blocking,omitempty"`
+	Slow        []string `json:"slow,omitempty"` // O(N) on the whole keyspace or on large keys
+	Admin       []string `json:"admin,omitempty"`
+	Allow       []string `json:"allow,omitempty"`     // Never confirmed, even when built in
+	LargeKey    int64    `json:"large_key,omitempty"` // Elements from which O(N) commands are confirmed, 10000 by default
 }
 
 // TLSConfig holds TLS configuration
@@ -40,6 +60,19 @@ type UIConfig struct {
 	MaxKeys         int    `json:"max_keys"`
 	ShowMemory      bool   `json:"show_memory"`
 	ShowTTL         bool   `json:"show_ttl"`
+	HistorySize     int    `json:"history_size"` // CLI commands kept per connection profile
+}
+
+// AlertsConfig holds the alert rules evaluated on each monitoring sample
+type AlertsConfig struct {
+	Rules []AlertRule `json:"rules,omitempty"`
+	Hook  string      `json:"hook,omitempty"` // Shell command run when a rule fires or resolves
+}
+
+// AlertRule is a named condition on INFO fields, e.g. "used_memory > 90% maxmemory"
+type AlertRule struct {
+	Name string `json:"name"`
+	Expr string `json:"expr"`
 }
 
 // Default returns a default configuration
@@ -52,6 +85,7 @@ func Default() *Config {
 			DB:       0,
 			Timeout:  5000,
 			PoolSize: 10,
+			Protocol: 3,
 			TLS: TLSConfig{
 				Enabled:            false,
 				CertFile:           "",
@@ -66,10 +100,53 @@ func Default() *Config {
 			MaxKeys:         1000,
 			ShowMemory:      true,
 			ShowTTL:         true,
+			HistorySize:     1000,
 		},
 	}
 }
 
+// Profile returns the named connection profile with unset fields taken from the defaults;
+// a read-only configuration makes every profile read-only
+func (c *Config) Profile(name string) (*RedisConfig, error) {
+	profile, ok := c.Profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown connection profile: %s", name)
+	}
+
+	defaults := Default().Redis
+	if profile.Host == "" {
+		profile.Host = defaults.Host
+	}
+	if profile.Port == 0 {
+		profile.Port = defaults.Port
+	}
+	if profile.Timeout == 0 {
+		profile.Timeout = defaults.Timeout
+	}
+	if profile.PoolSize == 0 {
+		profile.PoolSize = defaults.PoolSize
+	}
+	if profile.Protocol == 0 {
+		profile.Protocol = defaults.Protocol
+	}
+	if c.ReadOnly {
+		profile.ReadOnly = true
+	}
+	profile.Name = name
+
+	return &profile, nil
+}
+
+// ProfileNames returns the configured profile names in sorted order
+func (c *Config) ProfileNames() []string {
+	names := make([]string, 0, len(c.Profiles))
+	for name := range c.Profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
 // Load loads configuration from file or returns default
 func Load() (*Config, error) {
 	cfg := Default()
@@ -112,10 +189,15 @@ func (c *Config) Save() error {
 	return nil
 }
 
-func getConfigPath() string {
+// Dir returns the directory holding the config file and other saved state
+func Dir() string {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
-		return "./config.json"
+		return "."
 	}
-	return filepath.Join(homeDir, ".redis-valkey-tui", "config.json")
+	return filepath.Join(homeDir, ".redis-valkey-tui")
+}
+
+func getConfigPath() string {
+	return filepath.Join(Dir(), "config.json")
 }
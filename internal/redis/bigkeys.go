@@ -0,0 +1,169 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// BigKey is a key with its element count and memory usage
+type BigKey struct {
+	Key    string `json:"key"`
+	Type   string `json:"type"`
+	Length int64  `json:"length"` // Bytes for strings, elements for other types
+	Memory int64  `json:"memory"` // MEMORY USAGE in bytes, 0 when unavailable
+}
+
+// BigKeysOptions controls a big keys scan
+type BigKeysOptions struct {
+	Pattern   string // SCAN match pattern
+	BatchSize int    // Keys per SCAN and pipelined round trip
+	Top       int    // Keys kept per type
+
+	// Progress is called after every batch with the number of keys scanned
+	Progress func(scanned int64)
+}
+
+// BigKeysResult holds the largest keys per type and totals per type
+type BigKeysResult struct {
+	Scanned int64            `json:"scanned"`
+	Counts  map[string]int64 `json:"counts"` // Keys per type
+	Keys    []BigKey         `json:"keys"`   // Largest keys by memory (or length), grouped by type
+}
+
+// BigKeys scans the keyspace like redis-cli --bigkeys/--memkeys and keeps the largest keys per type
+func (c *Client) BigKeys(ctx context.Context, opts BigKeysOptions) (*BigKeysResult, error) {
+	c, release := c.pin()
+	defer release()
+	if opts.Pattern == "" {
+		opts.Pattern = "*"
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.Top <= 0 {
+		opts.Top = 10
+	}
+
+	result := &BigKeysResult{Counts: make(map[string]int64)}
+	top := make(map[string][]BigKey)
+
+	var cursor uint64
+	for {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		keys, next, err := c.db().Scan(ctx, cursor, opts.Pattern, int64(opts.BatchSize)).Result()
+		if err != nil {
+			return result, fmt.Errorf("failed to scan keys: %w", err)
+		}
+		cursor = next
+
+		if len(keys) > 0 {
+			sized, err := c.sizeKeys(ctx, keys)
+			if err != nil {
+				return result, err
+			}
+			for _, k := range sized {
+				result.Counts[k.Type]++
+				top[k.Type] = keepLargest(top[k.Type], k, opts.Top)
+			}
+			result.Scanned += int64(len(keys))
+			if opts.Progress != nil {
+				opts.Progress(result.Scanned)
+			}
+		}
+
+		if cursor == 0 {
+			break
+		}
+	}
+
+	types := make([]string, 0, len(top))
+	for t := range top {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	for _, t := range types {
+		result.Keys = append(result.Keys, top[t]...)
+	}
+	return result, nil
+}
+
+// sizeKeys fetches the type, length and memory usage of keys in two pipelined round trips
+func (c *Client) sizeKeys(ctx context.Context, keys []string) ([]BigKey, error) {
+	pipe := c.db().Pipeline()
+	typeCmds := make([]*redis.StatusCmd, len(keys))
+	for i, key := range keys {
+		typeCmds[i] = pipe.Type(ctx, key)
+	}
+	if _, err := pipe.Exec(ctx); err != nil && err != redis.Nil {
+		return nil, fmt.Errorf("failed to get key types: %w", err)
+	}
+
+	pipe = c.db().Pipeline()
+	lenCmds := make([]*redis.IntCmd, len(keys))
+	memCmds := make([]*redis.IntCmd, len(keys))
+	types := make([]string, len(keys))
+	for i, key := range keys {
+		types[i] = typeCmds[i].Val()
+		switch types[i] {
+		case "string":
+			lenCmds[i] = pipe.StrLen(ctx, key)
+		case "list":
+			lenCmds[i] = pipe.LLen(ctx, key)
+		case "set":
+			lenCmds[i] = pipe.SCard(ctx, key)
+		case "hash":
+			lenCmds[i] = pipe.HLen(ctx, key)
+		case "zset":
+			lenCmds[i] = pipe.ZCard(ctx, key)
+		case "stream":
+			lenCmds[i] = pipe.XLen(ctx, key)
+		}
+		memCmds[i] = pipe.MemoryUsage(ctx, key)
+	}
+	// Keys deleted since the SCAN and servers without MEMORY USAGE only fail their own commands
+	pipe.Exec(ctx)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	sized := make([]BigKey, 0, len(keys))
+	for i, key := range keys {
+		if types[i] == "" || types[i] == "none" {
+			continue
+		}
+		k := BigKey{Key: key, Type: types[i], Memory: memCmds[i].Val()}
+		if lenCmds[i] != nil {
+			k.Length = lenCmds[i].Val()
+		}
+		sized = append(sized, k)
+	}
+	return sized, nil
+}
+
+// keepLargest inserts k into list, which is sorted largest first, keeping at most n keys
+func keepLargest(list []BigKey, k BigKey, n int) []BigKey {
+	larger := func(a, b BigKey) bool {
+		if a.Memory != b.Memory {
+			return a.Memory > b.Memory
+		}
+		return a.Length > b.Length
+	}
+
+	i := sort.Search(len(list), func(i int) bool { return larger(k, list[i]) })
+	if i >= n {
+		return list
+	}
+	list = append(list, BigKey{})
+	copy(list[i+1:], list[i:])
+	list[i] = k
+	if len(list) > n {
+		list = list[:n]
+	}
+	return list
+}
@@ -0,0 +1,40 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestKeepLargest tests ordering by memory then length, ties and truncation to the top n
+func TestKeepLargest(t *testing.T) {
+	var list []BigKey
+	for _, k := range []BigKey{
+		{Key: "a", Memory: 100, Length: 1},
+		{Key: "b", Memory: 300, Length: 1},
+		{Key: "c", Memory: 100, Length: 5},
+		{Key: "d", Memory: 200, Length: 1},
+		{Key: "e", Memory: 100, Length: 1},
+		{Key: "f", Memory: 50, Length: 9},
+	} {
+		list = keepLargest(list, k, 4)
+	}
+
+	keys := make([]string, len(list))
+	for i, k := range list {
+		keys[i] = k.Key
+	}
+	// Equal keys keep their arrival order, and the smallest fall off the end
+	assert.Equal(t, []string{"b", "d", "c", "a"}, keys)
+
+	// A key smaller than a full list is not added
+	assert.Len(t, keepLargest(list, BigKey{Key: "g", Memory: 1}, 4), 4)
+	assert.Equal(t, "a", list[3].Key)
+
+	// A larger key pushes out the smallest
+	list = keepLargest(list, BigKey{Key: "h", Memory: 1000}, 4)
+	assert.Equal(t, "h", list[0].Key)
+	assert.Equal(t, "c", list[3].Key)
+
+	assert.Empty(t, keepLargest(nil, BigKey{Key: "x", Memory: 1}, 0))
+}
@@ -8,6 +8,7 @@ import (
 	"io/ioutil"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
@@ -15,10 +16,131 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// retireGrace is how long Reconnect waits before closing the old connection once its
+// last command finishes, so commands that fetched it just before the swap still start
+const retireGrace = 5 * time.Second
+
 // Client wraps the Redis client with additional functionality
 type Client struct {
-	rdb *redis.Client
-	ctx context.Context
+	ctx   context.Context
+	state *clientState // Shared with WithContext copies, so they follow Reconnect
+}
+
+// clientState holds the connection currently used by a client and its copies
+type clientState struct {
+	mu   sync.RWMutex
+	conn *clientConn
+}
+
+// clientConn is the connection to one server and database with its caches and policies
+type clientConn struct {
+	rdb      *redis.Client
+	conns    *connTracker
+	commands *commandCache
+	guard    *Guard
+	readOnly bool
+	cfg      config.RedisConfig // Settings the connection was made with
+
+	mu        sync.Mutex
+	users     int  // Commands and pinned operations in flight
+	retired   bool // Replaced by Reconnect; closed once users drops to zero
+	closeOnce sync.Once
+}
+
+// acquire marks the connection in use
+func (cc *clientConn) acquire() {
+	cc.mu.Lock()
+	cc.users++
+	cc.mu.Unlock()
+}
+
+// release ends a use and closes a retired connection after its last one
+func (cc *clientConn) release() {
+	cc.mu.Lock()
+	cc.users--
+	idle := cc.retired && cc.users == 0
+	cc.mu.Unlock()
+	if idle {
+		cc.close()
+	}
+}
+
+// retire closes the connection once the grace period has passed and its commands have finished
+func (cc *clientConn) retire() {
+	time.AfterFunc(retireGrace, func() {
+		cc.mu.Lock()
+		cc.retired = true
+		idle := cc.users == 0
+		cc.mu.Unlock()
+		if idle {
+			cc.close()
+		}
+	})
+}
+
+// close closes the go-redis client once
+func (cc *clientConn) close() error {
+	var err error
+	cc.closeOnce.Do(func() {
+		err = cc.rdb.Close()
+	})
+	return err
+}
+
+// useHook counts the commands in flight on a connection
+type useHook struct {
+	conn *clientConn
+}
+
+// DialHook leaves dialing unchanged
+func (h useHook) DialHook(next redis.DialHook) redis.DialHook {
+	return next
+}
+
+// ProcessHook counts a single command
+func (h useHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		h.conn.acquire()
+		defer h.conn.release()
+		return next(ctx, cmd)
+	}
+}
+
+// ProcessPipelineHook counts a pipeline or transaction
+func (h useHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		h.conn.acquire()
+		defer h.conn.release()
+		return next(ctx, cmds)
+	}
+}
+
+// conn returns the connection the client currently uses
+func (c *Client) conn() *clientConn {
+	c.state.mu.RLock()
+	defer c.state.mu.RUnlock()
+	return c.state.conn
+}
+
+// db returns the go-redis client of the current connection
+func (c *Client) db() *redis.Client {
+	return c.conn().rdb
+}
+
+// endpoint returns the address and database of the current connection, like host:6379/0
+func (c *Client) endpoint() string {
+	opts := c.db().Options()
+	return fmt.Sprintf("%s/%d", opts.Addr, opts.DB)
+}
+
+// pin returns a copy of the client bound to the current connection, for operations of
+// several commands that must not move to another server midway; call release when done
+func (c *Client) pin() (*Client, func()) {
+	c.state.mu.RLock()
+	cc := c.state.conn
+	cc.acquire()
+	c.state.mu.RUnlock()
+	return &Client{ctx: c.ctx, state: &clientState{conn: cc}}, cc.release
 }
 
 // New creates a new Redis client
@@ -27,6 +149,10 @@ func New(cfg *config.RedisConfig) (*Client, error) {
 		Addr:     fmt.Sprintf("%s:%d", cfg.Host, cfg.Port),
 		Password: cfg.Password,
 		DB:       cfg.DB,
+		Protocol: 3,
+	}
+	if cfg.Protocol == 2 {
+		opts.Protocol = 2
 	}
 
 	// Configure TLS if enabled
@@ -60,6 +186,9 @@ func New(cfg *config.RedisConfig) (*Client, error) {
 		opts.TLSConfig = tlsConfig
 	}
 
+	conns := newConnTracker()
+	opts.OnConnect = conns.onConnect
+
 	rdb := redis.NewClient(opts)
 
 	ctx := context.Background()
@@ -69,25 +198,65 @@ func New(cfg *config.RedisConfig) (*Client, error) {
 		return nil, fmt.Errorf("failed to connect to Redis: %w", err)
 	}
 
-	return &Client{
-		rdb: rdb,
-		ctx: ctx,
-	}, nil
+	cc := &clientConn{
+		rdb:      rdb,
+		conns:    conns,
+		commands: &commandCache{},
+		guard:    NewGuard(cfg.Guard),
+		readOnly: cfg.ReadOnly,
+		cfg:      *cfg,
+	}
+	c := &Client{ctx: ctx, state: &clientState{conn: cc}}
+	rdb.AddHook(useHook{conn: cc})
+	if cc.readOnly {
+		rdb.AddHook(readOnlyHook{client: c})
+	}
+	return c, nil
+}
+
+// Reconnect connects to another server or database and swaps the connection in place, so views
+// sharing the client follow. The old connection is closed once its commands in flight and
+// pinned operations have finished.
+func (c *Client) Reconnect(cfg *config.RedisConfig) error {
+	next, err := New(cfg)
+	if err != nil {
+		return err
+	}
+
+	c.state.mu.Lock()
+	old := c.state.conn
+	c.state.conn = next.conn()
+	c.state.mu.Unlock()
+
+	old.retire()
+	return nil
+}
+
+// Config returns the settings of the connection in use, including any overrides made for this run
+func (c *Client) Config() config.RedisConfig {
+	return c.conn().cfg
+}
+
+// WithContext returns a shallow copy of the client whose commands use ctx, e.g. to bound them with a timeout
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := *c
+	clone.ctx = ctx
+	return &clone
 }
 
 // Close closes the Redis connection
 func (c *Client) Close() error {
-	return c.rdb.Close()
+	return c.conn().close()
 }
 
 // Type returns the type of a key
 func (c *Client) Type(key string) (string, error) {
-	return c.rdb.Type(c.ctx, key).Result()
+	return c.db().Type(c.ctx, key).Result()
 }
 
 // TTL returns the TTL of a key in seconds
 func (c *Client) TTL(key string) (int64, error) {
-	duration, err := c.rdb.TTL(c.ctx, key).Result()
+	duration, err := c.db().TTL(c.ctx, key).Result()
 	if err != nil {
 		return -1, err
 	}
@@ -96,21 +265,23 @@ func (c *Client) TTL(key string) (int64, error) {
 
 // MemoryUsage returns the memory usage of a key in bytes
 func (c *Client) MemoryUsage(key string) (int64, error) {
-	return c.rdb.MemoryUsage(c.ctx, key).Result()
+	return c.db().MemoryUsage(c.ctx, key).Result()
 }
 
 // ObjectEncoding returns the internal encoding of a key
 func (c *Client) ObjectEncoding(key string) (string, error) {
-	return c.rdb.ObjectEncoding(c.ctx, key).Result()
+	return c.db().ObjectEncoding(c.ctx, key).Result()
 }
 
 // DBSize returns the number of keys in the current database
 func (c *Client) DBSize() (int64, error) {
-	return c.rdb.DBSize(c.ctx).Result()
+	return c.db().DBSize(c.ctx).Result()
 }
 
 // GetKeys returns all keys matching the pattern using SCAN for safety
 func (c *Client) GetKeys(pattern string) ([]string, error) {
+	c, release := c.pin()
+	defer release()
 	if pattern == "" {
 		pattern = "*"
 	}
@@ -123,7 +294,7 @@ func (c *Client) GetKeys(pattern string) ([]string, error) {
 		var scanKeys []string
 		var err error
 		
-		scanKeys, cursor, err = c.rdb.Scan(c.ctx, cursor, pattern, 100).Result()
+		scanKeys, cursor, err = c.db().Scan(c.ctx, cursor, pattern, 100).Result()
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan keys: %w", err)
 		}
@@ -144,6 +315,37 @@ func (c *Client) GetKeys(pattern string) ([]string, error) {
 	return keys, nil
 }
 
+// ScanKeys calls fn after every SCAN call with the keys it found matching pattern, of keyType when it is set,
+// which may be none; an error from fn stops the scan
+func (c *Client) ScanKeys(ctx context.Context, pattern, keyType string, fn func(keys []string) error) error {
+	c, release := c.pin()
+	defer release()
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	var cursor uint64
+	for {
+		var keys []string
+		var err error
+		if keyType != "" {
+			keys, cursor, err = c.db().ScanType(ctx, cursor, pattern, 100, keyType).Result()
+		} else {
+			keys, cursor, err = c.db().Scan(ctx, cursor, pattern, 100).Result()
+		}
+		if err != nil {
+			return fmt.Errorf("failed to scan keys: %w", err)
+		}
+
+		if err := fn(keys); err != nil {
+			return err
+		}
+		if cursor == 0 {
+			return nil
+		}
+	}
+}
+
 // GetKeyInfo returns information about a key
 func (c *Client) GetKeyInfo(key string) (*KeyInfo, error) {
 	info := &KeyInfo{
@@ -154,20 +356,20 @@ func (c *Client) GetKeyInfo(key string) (*KeyInfo, error) {
 	}
 
 	// Get key type - this is critical, so return error if it fails
-	keyType, err := c.rdb.Type(c.ctx, key).Result()
+	keyType, err := c.db().Type(c.ctx, key).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get key type for %s: %w", key, err)
 	}
 	info.Type = keyType
 
 	// Get TTL - don't fail if this doesn't work
-	ttl, err := c.rdb.TTL(c.ctx, key).Result()
+	ttl, err := c.db().TTL(c.ctx, key).Result()
 	if err == nil {
 		info.TTL = ttl
 	}
 
 	// Get memory usage (if supported) - don't fail if this doesn't work
-	memUsage, err := c.rdb.MemoryUsage(c.ctx, key).Result()
+	memUsage, err := c.db().MemoryUsage(c.ctx, key).Result()
 	if err == nil {
 		info.MemoryUsage = memUsage
 		info.Size = memUsage // Set Size to match MemoryUsage
@@ -184,28 +386,28 @@ func (c *Client) GetKeyInfo(key string) (*KeyInfo, error) {
 
 // GetValue returns the value of a key
 func (c *Client) GetValue(key string) (string, error) {
-	keyType, err := c.rdb.Type(c.ctx, key).Result()
+	keyType, err := c.db().Type(c.ctx, key).Result()
 	if err != nil {
 		return "", fmt.Errorf("failed to get key type: %w", err)
 	}
 
 	switch keyType {
 	case "string":
-		return c.rdb.Get(c.ctx, key).Result()
+		return c.db().Get(c.ctx, key).Result()
 	case "list":
-		values, err := c.rdb.LRange(c.ctx, key, 0, -1).Result()
+		values, err := c.db().LRange(c.ctx, key, 0, -1).Result()
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("[%s]", strings.Join(values, ", ")), nil
 	case "set":
-		values, err := c.rdb.SMembers(c.ctx, key).Result()
+		values, err := c.db().SMembers(c.ctx, key).Result()
 		if err != nil {
 			return "", err
 		}
 		return fmt.Sprintf("{%s}", strings.Join(values, ", ")), nil
 	case "hash":
-		values, err := c.rdb.HGetAll(c.ctx, key).Result()
+		values, err := c.db().HGetAll(c.ctx, key).Result()
 		if err != nil {
 			return "", err
 		}
@@ -215,7 +417,7 @@ func (c *Client) GetValue(key string) (string, error) {
 		}
 		return fmt.Sprintf("{%s}", strings.Join(pairs, ", ")), nil
 	case "zset":
-		values, err := c.rdb.ZRangeWithScores(c.ctx, key, 0, -1).Result()
+		values, err := c.db().ZRangeWithScores(c.ctx, key, 0, -1).Result()
 		if err != nil {
 			return "", err
 		}
@@ -231,22 +433,22 @@ func (c *Client) GetValue(key string) (string, error) {
 
 // SetValue sets the value of a key
 func (c *Client) SetValue(key, value string) error {
-	return c.rdb.Set(c.ctx, key, value, 0).Err()
+	return c.db().Set(c.ctx, key, value, 0).Err()
 }
 
 // DeleteKey deletes a key
 func (c *Client) DeleteKey(key string) error {
-	return c.rdb.Del(c.ctx, key).Err()
+	return c.db().Del(c.ctx, key).Err()
 }
 
 // SetTTL sets the TTL for a key
 func (c *Client) SetTTL(key string, ttl time.Duration) error {
-	return c.rdb.Expire(c.ctx, key, ttl).Err()
+	return c.db().Expire(c.ctx, key, ttl).Err()
 }
 
 // GetInfo returns server info
 func (c *Client) GetInfo() (map[string]string, error) {
-	info, err := c.rdb.Info(c.ctx).Result()
+	info, err := c.db().Info(c.ctx).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get server info: %w", err)
 	}
@@ -269,15 +471,73 @@ func (c *Client) GetInfo() (map[string]string, error) {
 	return result, nil
 }
 
+// InfoField is one field of INFO with the section it belongs to
+type InfoField struct {
+	Section string `json:"section"`
+	Field   string `json:"field"`
+	Value   string `json:"value"`
+}
+
+// InfoFields returns the fields of one INFO section, or of the default sections when section is empty
+func (c *Client) InfoFields(section string) ([]InfoField, error) {
+	var sections []string
+	if section != "" {
+		sections = append(sections, section)
+	}
+	info, err := c.db().Info(c.ctx, sections...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server info: %w", err)
+	}
+	return parseInfoFields(info), nil
+}
+
+// parseInfoFields parses INFO output into fields tagged with the lower-case name of their section
+func parseInfoFields(info string) []InfoField {
+	var fields []InfoField
+	current := ""
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+		if strings.HasPrefix(line, "#") {
+			current = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(line, "#")))
+			continue
+		}
+		if parts := strings.SplitN(line, ":", 2); len(parts) == 2 {
+			fields = append(fields, InfoField{Section: current, Field: parts[0], Value: parts[1]})
+		}
+	}
+	return fields
+}
+
 // ExecuteCommand executes a Redis command
 func (c *Client) ExecuteCommand(cmd string, args ...interface{}) (interface{}, error) {
 	cmdArgs := append([]interface{}{cmd}, args...)
-	return c.rdb.Do(c.ctx, cmdArgs...).Result()
+	return c.db().Do(c.ctx, cmdArgs...).Result()
+}
+
+// GetConfigValue returns a single server configuration parameter from CONFIG GET
+func (c *Client) GetConfigValue(param string) (string, error) {
+	values, err := c.db().ConfigGet(c.ctx, param).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to get %s: %w", param, err)
+	}
+	value, ok := values[param]
+	if !ok {
+		return "", fmt.Errorf("unknown configuration parameter: %s", param)
+	}
+	return value, nil
+}
+
+// SetConfigValue changes a server configuration parameter with CONFIG SET
+func (c *Client) SetConfigValue(param, value string) error {
+	if err := c.db().ConfigSet(c.ctx, param, value).Err(); err != nil {
+		return fmt.Errorf("failed to set %s: %w", param, err)
+	}
+	return nil
 }
 
 // Info returns Redis INFO command output
 func (c *Client) Info() (map[string]interface{}, error) {
-	info, err := c.rdb.Info(c.ctx).Result()
+	info, err := c.db().Info(c.ctx).Result()
 	if err != nil {
 		return nil, err
 	}
@@ -353,6 +613,24 @@ func (c *Client) GetMetrics() (*Metrics, error) {
 	if val, ok := info["uptime_in_seconds"]; ok {
 		metrics.UptimeInSeconds, _ = strconv.ParseInt(val, 10, 64)
 	}
+	if val, ok := info["maxmemory"]; ok {
+		metrics.MaxMemory, _ = strconv.ParseUint(val, 10, 64)
+	}
+	if val, ok := info["mem_fragmentation_ratio"]; ok {
+		metrics.MemFragmentationRatio, _ = strconv.ParseFloat(val, 64)
+	}
+	if val, ok := info["total_net_input_bytes"]; ok {
+		metrics.TotalNetInputBytes, _ = strconv.ParseInt(val, 10, 64)
+	}
+	if val, ok := info["total_net_output_bytes"]; ok {
+		metrics.TotalNetOutputBytes, _ = strconv.ParseInt(val, 10, 64)
+	}
+	if val, ok := info["expired_keys"]; ok {
+		metrics.ExpiredKeys, _ = strconv.ParseInt(val, 10, 64)
+	}
+	if val, ok := info["evicted_keys"]; ok {
+		metrics.EvictedKeys, _ = strconv.ParseInt(val, 10, 64)
+	}
 
 	return metrics, nil
 }
@@ -378,29 +656,35 @@ type Metrics struct {
 	KeyspaceMisses         int64
 	InstantaneousOpsPerSec int64
 	UptimeInSeconds        int64
+	MaxMemory              uint64
+	MemFragmentationRatio  float64
+	TotalNetInputBytes     int64
+	TotalNetOutputBytes    int64
+	ExpiredKeys            int64
+	EvictedKeys            int64
 }
 
 // getApproximateSize tries to get an approximate size for a key when MemoryUsage fails
 func (c *Client) getApproximateSize(key, keyType string) int64 {
 	switch keyType {
 	case "string":
-		if val, err := c.rdb.Get(c.ctx, key).Result(); err == nil {
+		if val, err := c.db().Get(c.ctx, key).Result(); err == nil {
 			return int64(len(val))
 		}
 	case "list":
-		if length, err := c.rdb.LLen(c.ctx, key).Result(); err == nil {
+		if length, err := c.db().LLen(c.ctx, key).Result(); err == nil {
 			return length * 50 // Rough estimate
 		}
 	case "set":
-		if length, err := c.rdb.SCard(c.ctx, key).Result(); err == nil {
+		if length, err := c.db().SCard(c.ctx, key).Result(); err == nil {
 			return length * 50 // Rough estimate
 		}
 	case "hash":
-		if length, err := c.rdb.HLen(c.ctx, key).Result(); err == nil {
+		if length, err := c.db().HLen(c.ctx, key).Result(); err == nil {
 			return length * 100 // Rough estimate
 		}
 	case "zset":
-		if length, err := c.rdb.ZCard(c.ctx, key).Result(); err == nil {
+		if length, err := c.db().ZCard(c.ctx, key).Result(); err == nil {
 			return length * 100 // Rough estimate
 		}
 	}
@@ -409,7 +693,7 @@ func (c *Client) getApproximateSize(key, keyType string) int64 {
 
 // ClusterNodes returns cluster nodes information
 func (c *Client) ClusterNodes() (string, error) {
-	return c.rdb.ClusterNodes(c.ctx).Result()
+	return c.db().ClusterNodes(c.ctx).Result()
 }
 
 // CommandStat represents statistics for a single Redis command
@@ -420,11 +704,17 @@ type CommandStat struct {
 	DurationPerCall float64 // in milliseconds
 	RejectedCalls  int64
 	FailedCalls    int64
+
+	// Latency percentiles in microseconds from INFO latencystats (Redis 7+)
+	HasPercentiles bool
+	P50            float64
+	P99            float64
+	P999           float64
 }
 
 // GetCommandStats returns command statistics from Redis INFO commandstats
 func (c *Client) GetCommandStats() ([]CommandStat, error) {
-	info, err := c.rdb.Info(c.ctx, "commandstats").Result()
+	info, err := c.db().Info(c.ctx, "commandstats").Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get command stats: %w", err)
 	}
@@ -486,24 +776,106 @@ func (c *Client) GetCommandStats() ([]CommandStat, error) {
 		stats = append(stats, stat)
 	}
 
+	// Older servers have no latencystats section; the percentiles are then left empty
+	if latency, err := c.db().Info(c.ctx, "latencystats").Result(); err == nil {
+		percentiles := parseLatencyStats(latency)
+		for i := range stats {
+			if p, ok := percentiles[stats[i].Command]; ok {
+				stats[i].HasPercentiles = true
+				stats[i].P50, stats[i].P99, stats[i].P999 = p[0], p[1], p[2]
+			}
+		}
+	}
+
 	return stats, nil
 }
 
+// parseLatencyStats parses INFO latencystats into p50, p99 and p99.9 per command
+func parseLatencyStats(info string) map[string][3]float64 {
+	percentiles := make(map[string][3]float64)
+	for _, line := range strings.Split(info, "\n") {
+		// Parse line like: latency_percentiles_usec_get:p50=1.003,p99=2.007,p99.9=5.023
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "latency_percentiles_usec_") {
+			continue
+		}
+		parts := strings.SplitN(strings.TrimPrefix(line, "latency_percentiles_usec_"), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		var p [3]float64
+		for _, pair := range strings.Split(parts[1], ",") {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			val, err := strconv.ParseFloat(kv[1], 64)
+			if err != nil {
+				continue
+			}
+			switch kv[0] {
+			case "p50":
+				p[0] = val
+			case "p99":
+				p[1] = val
+			case "p99.9":
+				p[2] = val
+			}
+		}
+		percentiles[parts[0]] = p
+	}
+	return percentiles
+}
+
+// ResetStats resets the server statistics reported by INFO with CONFIG RESETSTAT
+func (c *Client) ResetStats() error {
+	if err := c.db().ConfigResetStat(c.ctx).Err(); err != nil {
+		return fmt.Errorf("failed to reset stats: %w", err)
+	}
+	return nil
+}
+
 // ClientInfo represents information about a connected client
 type ClientInfo struct {
 	ID            string
 	Address       string
-	Age           int64  // Connection age in seconds
-	Idle          int64  // Idle time in seconds
+	LocalAddress  string
+	FD            int64
+	Age           int64 // Connection age in seconds
+	Idle          int64 // Idle time in seconds
+	Flags         string
 	LastCommand   string
 	DB            int
 	Name          string
+	User          string
+	LibName       string
+	LibVersion    string
+	Subscriptions int64 // Channel subscriptions
+	PatternSubs   int64 // Pattern subscriptions
+	ShardSubs     int64 // Shard channel subscriptions
+	Multi         int64 // Commands queued in MULTI, -1 outside a transaction
+	Watch         int64 // Keys watched
+	QueryBuf      int64 // Query buffer length in bytes
+	QueryBufFree  int64
+	ArgvMem       int64
+	MultiMem      int64
+	OutputBuf     int64 // Output buffer length (obl)
+	OutputList    int64 // Output list length (oll)
+	OutputMem     int64 // Output buffer memory (omem)
+	TotalMem      int64 // Total memory used by the client (tot-mem)
+	Events        string
+	Redirect      int64
+	RESP          int
 	TotalDuration float64 // Calculated from age
+
+	// Fields holds every key=value pair of the CLIENT LIST line, including ones not listed above
+	Fields map[string]string
 }
 
 // GetClientList returns information about connected clients
 func (c *Client) GetClientList() ([]ClientInfo, error) {
-	result, err := c.rdb.ClientList(c.ctx).Result()
+	result, err := c.db().ClientList(c.ctx).Result()
 	if err != nil {
 		return nil, fmt.Errorf("failed to get client list: %w", err)
 	}
@@ -517,46 +889,91 @@ func (c *Client) GetClientList() ([]ClientInfo, error) {
 			continue
 		}
 
-		client := ClientInfo{}
-		
-		// Parse client info line with key=value pairs
-		pairs := strings.Split(line, " ")
-		for _, pair := range pairs {
-			kv := strings.SplitN(pair, "=", 2)
-			if len(kv) != 2 {
-				continue
-			}
+		clients = append(clients, ParseClientInfo(line))
+	}
 
-			key, value := kv[0], kv[1]
-			
-			switch key {
-			case "id":
-				client.ID = value
-			case "addr":
-				client.Address = value
-			case "age":
-				if val, err := strconv.ParseInt(value, 10, 64); err == nil {
-					client.Age = val
-					// Calculate total duration in minutes from age
-					client.TotalDuration = float64(val) / 60.0
-				}
-			case "idle":
-				if val, err := strconv.ParseInt(value, 10, 64); err == nil {
-					client.Idle = val
-				}
-			case "cmd":
-				client.LastCommand = value
-			case "db":
-				if val, err := strconv.Atoi(value); err == nil {
-					client.DB = val
-				}
-			case "name":
-				client.Name = value
-			}
-		}
+	return clients, nil
+}
+
+// ParseClientInfo parses one CLIENT LIST line of key=value pairs
+func ParseClientInfo(line string) ClientInfo {
+	client := ClientInfo{Fields: make(map[string]string)}
 
-		clients = append(clients, client)
+	parseInt := func(value string) int64 {
+		n, _ := strconv.ParseInt(value, 10, 64)
+		return n
 	}
 
-	return clients, nil
+	for _, pair := range strings.Split(line, " ") {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			continue
+		}
+		client.Fields[key] = value
+
+		switch key {
+		case "id":
+			client.ID = value
+		case "addr":
+			client.Address = value
+		case "laddr":
+			client.LocalAddress = value
+		case "fd":
+			client.FD = parseInt(value)
+		case "age":
+			client.Age = parseInt(value)
+			// Calculate total duration in minutes from age
+			client.TotalDuration = float64(client.Age) / 60.0
+		case "idle":
+			client.Idle = parseInt(value)
+		case "flags":
+			client.Flags = value
+		case "cmd":
+			client.LastCommand = value
+		case "db":
+			client.DB = int(parseInt(value))
+		case "name":
+			client.Name = value
+		case "user":
+			client.User = value
+		case "lib-name":
+			client.LibName = value
+		case "lib-ver":
+			client.LibVersion = value
+		case "sub":
+			client.Subscriptions = parseInt(value)
+		case "psub":
+			client.PatternSubs = parseInt(value)
+		case "ssub":
+			client.ShardSubs = parseInt(value)
+		case "multi":
+			client.Multi = parseInt(value)
+		case "watch":
+			client.Watch = parseInt(value)
+		case "qbuf":
+			client.QueryBuf = parseInt(value)
+		case "qbuf-free":
+			client.QueryBufFree = parseInt(value)
+		case "argv-mem":
+			client.ArgvMem = parseInt(value)
+		case "multi-mem":
+			client.MultiMem = parseInt(value)
+		case "obl":
+			client.OutputBuf = parseInt(value)
+		case "oll":
+			client.OutputList = parseInt(value)
+		case "omem":
+			client.OutputMem = parseInt(value)
+		case "tot-mem":
+			client.TotalMem = parseInt(value)
+		case "events":
+			client.Events = value
+		case "redir":
+			client.Redirect = parseInt(value)
+		case "resp":
+			client.RESP = int(parseInt(value))
+		}
+	}
+
+	return client
 }
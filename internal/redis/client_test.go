@@ -0,0 +1,111 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseClientInfo tests parsing CLIENT LIST lines of current and older servers
+func TestParseClientInfo(t *testing.T) {
+	// Redis 7.2 with an unnamed client and the library fields
+	info := ParseClientInfo("id=7 addr=127.0.0.1:52914 laddr=127.0.0.1:6379 fd=8 name= age=125 idle=3 flags=N db=2 sub=1 psub=2 ssub=0 multi=-1 watch=0 qbuf=26 qbuf-free=20448 argv-mem=10 multi-mem=0 rbs=1024 rbp=0 obl=0 oll=0 omem=0 tot-mem=22426 events=r cmd=client|list user=default redir=-1 resp=3 lib-name=go-redis(,go1.21.0) lib-ver=9.3.0")
+	assert.Equal(t, "7", info.ID)
+	assert.Equal(t, "127.0.0.1:52914", info.Address)
+	assert.Equal(t, "127.0.0.1:6379", info.LocalAddress)
+	assert.Equal(t, int64(8), info.FD)
+	assert.Equal(t, "", info.Name)
+	assert.Equal(t, int64(125), info.Age)
+	assert.InDelta(t, 125.0/60, info.TotalDuration, 1e-9)
+	assert.Equal(t, int64(3), info.Idle)
+	assert.Equal(t, "N", info.Flags)
+	assert.Equal(t, 2, info.DB)
+	assert.Equal(t, int64(1), info.Subscriptions)
+	assert.Equal(t, int64(2), info.PatternSubs)
+	assert.Equal(t, int64(-1), info.Multi)
+	assert.Equal(t, int64(26), info.QueryBuf)
+	assert.Equal(t, int64(22426), info.TotalMem)
+	assert.Equal(t, "client|list", info.LastCommand)
+	assert.Equal(t, "default", info.User)
+	assert.Equal(t, int64(-1), info.Redirect)
+	assert.Equal(t, 3, info.RESP)
+	assert.Equal(t, "go-redis(,go1.21.0)", info.LibName)
+	assert.Equal(t, "9.3.0", info.LibVersion)
+
+	// Fields keeps every pair, including empty and unknown ones
+	name, ok := info.Fields["name"]
+	assert.True(t, ok)
+	assert.Equal(t, "", name)
+	assert.Equal(t, "1024", info.Fields["rbs"])
+
+	// Redis 5 has no laddr, user, lib or RESP fields
+	info = ParseClientInfo("id=3 addr=10.0.0.5:40021 fd=7 name=worker-1 age=9 idle=0 flags=x db=0 sub=0 psub=0 multi=2 qbuf=0 qbuf-free=0 obl=0 oll=0 omem=0 events=r cmd=exec")
+	assert.Equal(t, "worker-1", info.Name)
+	assert.Equal(t, "", info.LocalAddress)
+	assert.Equal(t, "", info.User)
+	assert.Equal(t, "", info.LibName)
+	assert.Equal(t, "", info.LibVersion)
+	assert.Equal(t, int64(0), info.ShardSubs)
+	assert.Equal(t, int64(2), info.Multi)
+	assert.Equal(t, 0, info.RESP)
+	assert.Equal(t, "exec", info.LastCommand)
+	_, ok = info.Fields["lib-name"]
+	assert.False(t, ok)
+
+	// Malformed values and pairs are skipped rather than failing the line
+	info = ParseClientInfo("id=9 age=soon garbage db=1")
+	assert.Equal(t, "9", info.ID)
+	assert.Equal(t, int64(0), info.Age)
+	assert.Equal(t, 1, info.DB)
+	assert.Len(t, info.Fields, 3)
+}
+
+// TestParseLatencyStats tests parsing INFO latencystats, including subcommands
+func TestParseLatencyStats(t *testing.T) {
+	info := "# Latencystats\r\n" +
+		"latency_percentiles_usec_get:p50=1.003,p99=2.007,p99.9=5.023\r\n" +
+		"latency_percentiles_usec_config|set:p50=23.039,p99=40.191,p99.9=40.191\r\n" +
+		"latency_percentiles_usec_client|list:p50=12.031,p99=12.031,p99.9=12.031\r\n"
+
+	stats := parseLatencyStats(info)
+	assert.Equal(t, map[string][3]float64{
+		"get":         {1.003, 2.007, 5.023},
+		"config|set":  {23.039, 40.191, 40.191},
+		"client|list": {12.031, 12.031, 12.031},
+	}, stats)
+
+	// latency-tracking-info-percentiles may list other percentiles; only p50, p99 and p99.9 are kept
+	stats = parseLatencyStats("latency_percentiles_usec_set:p25=0.5,p50=1.5,p100=9.1\n" +
+		"latency_percentiles_usec_del:p50=bad,p99=3\n" +
+		"latency_percentiles_usec_broken\n" +
+		"cmdstat_get:calls=1,usec=1,usec_per_call=1.00\n")
+	assert.Equal(t, map[string][3]float64{
+		"set": {1.5, 0, 0},
+		"del": {0, 3, 0},
+	}, stats)
+
+	assert.Empty(t, parseLatencyStats(""))
+}
+
+// TestParseInfoFields tests that INFO fields carry the lower-case name of their section
+func TestParseInfoFields(t *testing.T) {
+	info := "# Server\r\n" +
+		"redis_version:7.2.4\r\n" +
+		"executable:/usr/bin/redis-server\r\n" +
+		"\r\n" +
+		"# Keyspace\r\n" +
+		"db0:keys=3,expires=0,avg_ttl=0\r\n" +
+		"# Commandstats\r\n" +
+		"cmdstat_config|get:calls=2,usec=10,usec_per_call=5.00\r\n"
+
+	assert.Equal(t, []InfoField{
+		{Section: "server", Field: "redis_version", Value: "7.2.4"},
+		{Section: "server", Field: "executable", Value: "/usr/bin/redis-server"},
+		{Section: "keyspace", Field: "db0", Value: "keys=3,expires=0,avg_ttl=0"},
+		{Section: "commandstats", Field: "cmdstat_config|get", Value: "calls=2,usec=10,usec_per_call=5.00"},
+	}, parseInfoFields(info))
+
+	// Fields before any header have no section; lines without a colon are skipped
+	assert.Equal(t, []InfoField{{Field: "role", Value: "master"}}, parseInfoFields("role:master\nnot a field\n"))
+	assert.Empty(t, parseInfoFields(""))
+}
@@ -0,0 +1,169 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// connTracker remembers the IDs of this application's own connections
+type connTracker struct {
+	mu      sync.Mutex
+	ids     map[int64]bool
+	noEvict bool
+}
+
+// newConnTracker creates an empty tracker
+func newConnTracker() *connTracker {
+	return &connTracker{ids: make(map[int64]bool)}
+}
+
+// onConnect records a new pooled connection and applies the NO-EVICT setting to it
+func (t *connTracker) onConnect(ctx context.Context, cn *redis.Conn) error {
+	id, err := cn.ClientID(ctx).Result()
+	if err != nil {
+		// CLIENT ID is missing on very old servers; tracking is best effort
+		return nil
+	}
+
+	t.mu.Lock()
+	t.ids[id] = true
+	noEvict := t.noEvict
+	t.mu.Unlock()
+
+	if noEvict {
+		cn.Process(ctx, redis.NewCmd(ctx, "CLIENT", "NO-EVICT", "ON"))
+	}
+	return nil
+}
+
+// IsOwnConnection reports whether a CLIENT LIST id belongs to this application
+func (c *Client) IsOwnConnection(id string) bool {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || c.conn().conns == nil {
+		return false
+	}
+
+	c.conn().conns.mu.Lock()
+	defer c.conn().conns.mu.Unlock()
+	return c.conn().conns.ids[n]
+}
+
+// ClientKillFilter selects the clients closed by CLIENT KILL; empty fields are not used
+type ClientKillFilter struct {
+	ID        string
+	Addr      string
+	LocalAddr string
+	User      string
+	Type      string // normal, master, replica or pubsub
+	MaxAge    int64  // Seconds; clients older than this are closed
+	SkipMe    bool
+}
+
+// args returns the CLIENT KILL filter arguments
+func (f ClientKillFilter) args() []interface{} {
+	args := []interface{}{"CLIENT", "KILL"}
+	if f.ID != "" {
+		args = append(args, "ID", f.ID)
+	}
+	if f.Addr != "" {
+		args = append(args, "ADDR", f.Addr)
+	}
+	if f.LocalAddr != "" {
+		args = append(args, "LADDR", f.LocalAddr)
+	}
+	if f.User != "" {
+		args = append(args, "USER", f.User)
+	}
+	if f.Type != "" {
+		args = append(args, "TYPE", f.Type)
+	}
+	if f.MaxAge > 0 {
+		args = append(args, "MAXAGE", f.MaxAge)
+	}
+	if f.SkipMe {
+		args = append(args, "SKIPME", "yes")
+	} else {
+		args = append(args, "SKIPME", "no")
+	}
+	return args
+}
+
+// KillClients closes the clients matching the filter and returns how many were closed
+func (c *Client) KillClients(f ClientKillFilter) (int64, error) {
+	if f.ID == "" && f.Addr == "" && f.LocalAddr == "" && f.User == "" && f.Type == "" && f.MaxAge <= 0 {
+		return 0, fmt.Errorf("refusing to kill clients without a filter")
+	}
+
+	n, err := c.db().Do(c.ctx, f.args()...).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to kill clients: %w", err)
+	}
+	return n, nil
+}
+
+// PauseClients suspends clients for the given time; writeOnly pauses only write commands
+func (c *Client) PauseClients(d time.Duration, writeOnly bool) error {
+	mode := "ALL"
+	if writeOnly {
+		mode = "WRITE"
+	}
+	if err := c.db().Do(c.ctx, "CLIENT", "PAUSE", d.Milliseconds(), mode).Err(); err != nil {
+		return fmt.Errorf("failed to pause clients: %w", err)
+	}
+	return nil
+}
+
+// UnpauseClients resumes clients paused by CLIENT PAUSE
+func (c *Client) UnpauseClients() error {
+	if err := c.db().Do(c.ctx, "CLIENT", "UNPAUSE").Err(); err != nil {
+		return fmt.Errorf("failed to unpause clients: %w", err)
+	}
+	return nil
+}
+
+// NoEvict reports whether this application's connections are excluded from client eviction
+func (c *Client) NoEvict() bool {
+	c.conn().conns.mu.Lock()
+	defer c.conn().conns.mu.Unlock()
+	return c.conn().conns.noEvict
+}
+
+// SetNoEvict sets CLIENT NO-EVICT on this application's connections.
+// The command only affects the connection that sends it, so it is sent on every pooled
+// connection and remembered for connections opened later.
+func (c *Client) SetNoEvict(on bool) error {
+	mode := "OFF"
+	if on {
+		mode = "ON"
+	}
+
+	c.conn().conns.mu.Lock()
+	c.conn().conns.noEvict = on
+	c.conn().conns.mu.Unlock()
+
+	// Holding one dedicated connection per pooled connection makes each take a different one
+	total := int(c.db().PoolStats().TotalConns)
+	if total < 1 {
+		total = 1
+	}
+	conns := make([]*redis.Conn, 0, total)
+	defer func() {
+		for _, cn := range conns {
+			cn.Close()
+		}
+	}()
+
+	for i := 0; i < total; i++ {
+		cn := c.db().Conn()
+		conns = append(conns, cn)
+		if err := cn.Process(c.ctx, redis.NewCmd(c.ctx, "CLIENT", "NO-EVICT", mode)); err != nil {
+			return fmt.Errorf("failed to set CLIENT NO-EVICT %s: %w", mode, err)
+		}
+	}
+	return nil
+}
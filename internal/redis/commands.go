@@ -0,0 +1,356 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// CommandArg is an argument of a command as described by COMMAND DOCS
+type CommandArg struct {
+	Name     string
+	Display  string // Name shown in the syntax, e.g. "key" or "seconds"
+	Type     string // key, string, integer, double, pattern, unix-time, pure-token, oneof or block
+	Token    string // Literal keyword preceding the value, e.g. EX
+	Optional bool
+	Multiple bool
+	Args     []CommandArg // Alternatives of a oneof, parts of a block
+}
+
+// CommandSpec describes a command or subcommand from COMMAND INFO and COMMAND DOCS
+type CommandSpec struct {
+	Name     string // Upper case; subcommands include the container, e.g. "CONFIG GET"
+	Arity    int    // Negative when the command takes at least -Arity arguments
+	Flags    []string
+	FirstKey int // Position of the first key counting the command name as 0, 0 without keys
+	LastKey  int // Negative counts from the end
+	KeyStep  int
+
+	Summary     string
+	Group       string
+	Since       string
+	Args        []CommandArg
+	Subcommands map[string]*CommandSpec // Keyed by the upper-case subcommand name
+}
+
+// HasFlag reports whether COMMAND INFO lists a flag such as write or readonly
+func (s *CommandSpec) HasFlag(flag string) bool {
+	for _, f := range s.Flags {
+		if strings.EqualFold(f, flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Depth returns how many words the command name has: 1 for commands, 2 for subcommands
+func (s *CommandSpec) Depth() int {
+	return strings.Count(s.Name, " ") + 1
+}
+
+// IsKey reports whether the argument at pos, counting the command name as 0, is a key name
+func (s *CommandSpec) IsKey(pos int) bool {
+	if s.FirstKey > 0 {
+		if pos < s.FirstKey {
+			return false
+		}
+		if s.LastKey > 0 && pos > s.LastKey {
+			return false
+		}
+		step := s.KeyStep
+		if step <= 0 {
+			step = 1
+		}
+		return (pos-s.FirstKey)%step == 0
+	}
+
+	// Commands with movable keys: follow the fixed leading arguments of the docs
+	i := s.Depth()
+	for _, arg := range s.Args {
+		if arg.Optional || arg.Token != "" || arg.Type == "oneof" || arg.Type == "block" {
+			return false
+		}
+		if arg.Multiple {
+			return arg.Type == "key" && pos >= i
+		}
+		if i == pos {
+			return arg.Type == "key"
+		}
+		i++
+	}
+	return false
+}
+
+// Syntax returns the usage line of the command, e.g. "GET key"
+func (s *CommandSpec) Syntax() string {
+	parts := []string{s.Name}
+	for _, arg := range s.Args {
+		parts = append(parts, arg.syntax())
+	}
+	if len(s.Args) == 0 && len(s.Subcommands) > 0 {
+		parts = append(parts, "<subcommand>")
+	}
+	return strings.Join(parts, " ")
+}
+
+// syntax renders an argument the way the Redis documentation does
+func (a CommandArg) syntax() string {
+	var text string
+	switch a.Type {
+	case "pure-token":
+		text = a.Token
+	case "oneof", "block":
+		sep := " | "
+		if a.Type == "block" {
+			sep = " "
+		}
+		parts := make([]string, len(a.Args))
+		for i, sub := range a.Args {
+			parts[i] = sub.syntax()
+		}
+		text = strings.Join(parts, sep)
+		if a.Token != "" {
+			text = a.Token + " " + text
+		}
+		if a.Type == "oneof" && !a.Optional && a.Token == "" {
+			text = "<" + text + ">"
+		}
+	default:
+		text = a.Display
+		if text == "" {
+			text = a.Name
+		}
+		if a.Token != "" {
+			text = a.Token + " " + text
+		}
+	}
+
+	if a.Multiple {
+		text = text + " [" + text + " ...]"
+	}
+	if a.Optional {
+		text = "[" + text + "]"
+	}
+	return text
+}
+
+// Tokens returns every keyword the argument or its children accept, e.g. EX, PX and KEEPTTL
+func (a CommandArg) Tokens() []string {
+	var tokens []string
+	if a.Token != "" {
+		tokens = append(tokens, strings.ToUpper(a.Token))
+	}
+	for _, sub := range a.Args {
+		tokens = append(tokens, sub.Tokens()...)
+	}
+	return tokens
+}
+
+// CommandTable holds the commands the server knows, module commands included
+type CommandTable map[string]*CommandSpec
+
+// Names returns the command names sorted alphabetically
+func (t CommandTable) Names() []string {
+	names := make([]string, 0, len(t))
+	for name := range t {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Lookup finds the command, or subcommand, that args start with
+func (t CommandTable) Lookup(args []string) *CommandSpec {
+	if len(args) == 0 {
+		return nil
+	}
+	spec := t[strings.ToUpper(args[0])]
+	if spec == nil {
+		return nil
+	}
+	if len(args) > 1 && len(spec.Subcommands) > 0 {
+		if sub := spec.Subcommands[strings.ToUpper(args[1])]; sub != nil {
+			return sub
+		}
+	}
+	return spec
+}
+
+// commandCache keeps the command table of the connected server
+type commandCache struct {
+	mu    sync.Mutex
+	table CommandTable
+}
+
+// Commands returns the server's command table from COMMAND INFO and COMMAND DOCS, loading it once
+func (c *Client) Commands() (CommandTable, error) {
+	c.conn().commands.mu.Lock()
+	defer c.conn().commands.mu.Unlock()
+	if c.conn().commands.table != nil {
+		return c.conn().commands.table, nil
+	}
+
+	reply, err := c.db().Do(c.ctx, "COMMAND").Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get command info: %w", err)
+	}
+	table := ParseCommandInfo(reply)
+
+	// COMMAND DOCS needs Redis 7; older servers still get names, arity and key positions
+	if docs, err := c.db().Do(c.ctx, "COMMAND", "DOCS").Result(); err == nil {
+		ParseCommandDocs(table, docs)
+	}
+
+	c.conn().commands.table = table
+	return table, nil
+}
+
+// ParseCommandInfo parses a COMMAND or COMMAND INFO reply
+func ParseCommandInfo(reply []interface{}) CommandTable {
+	table := make(CommandTable, len(reply))
+	for _, entry := range reply {
+		if spec := parseCommandEntry(entry); spec != nil {
+			table[spec.Name] = spec
+		}
+	}
+	return table
+}
+
+// parseCommandEntry parses one command of a COMMAND reply, including its subcommands
+func parseCommandEntry(entry interface{}) *CommandSpec {
+	fields, ok := entry.([]interface{})
+	if !ok || len(fields) < 6 {
+		return nil
+	}
+
+	spec := &CommandSpec{
+		Name:     strings.ToUpper(strings.ReplaceAll(replyString(fields[0]), "|", " ")),
+		Arity:    replyInt(fields[1]),
+		Flags:    replyStrings(fields[2]),
+		FirstKey: replyInt(fields[3]),
+		LastKey:  replyInt(fields[4]),
+		KeyStep:  replyInt(fields[5]),
+	}
+	if len(fields) >= 10 {
+		subs, _ := fields[9].([]interface{})
+		for _, s := range subs {
+			if sub := parseCommandEntry(s); sub != nil {
+				if spec.Subcommands == nil {
+					spec.Subcommands = make(map[string]*CommandSpec)
+				}
+				spec.Subcommands[sub.Name[strings.LastIndex(sub.Name, " ")+1:]] = sub
+			}
+		}
+	}
+	return spec
+}
+
+// ParseCommandDocs adds summaries and argument descriptions from a COMMAND DOCS reply to the table
+func ParseCommandDocs(table CommandTable, reply interface{}) {
+	for name, doc := range replyMap(reply) {
+		spec := table[strings.ToUpper(name)]
+		if spec == nil {
+			continue
+		}
+		applyCommandDoc(spec, replyMap(doc))
+	}
+}
+
+// applyCommandDoc copies one command's documentation into its spec
+func applyCommandDoc(spec *CommandSpec, doc map[string]interface{}) {
+	spec.Summary = replyString(doc["summary"])
+	spec.Group = replyString(doc["group"])
+	spec.Since = replyString(doc["since"])
+	if args, ok := doc["arguments"].([]interface{}); ok {
+		spec.Args = parseCommandArgs(args)
+	}
+	for name, subDoc := range replyMap(doc["subcommands"]) {
+		short := strings.ToUpper(name[strings.LastIndex(name, "|")+1:])
+		if sub := spec.Subcommands[short]; sub != nil {
+			applyCommandDoc(sub, replyMap(subDoc))
+		}
+	}
+}
+
+// parseCommandArgs parses the arguments array of COMMAND DOCS
+func parseCommandArgs(reply []interface{}) []CommandArg {
+	args := make([]CommandArg, 0, len(reply))
+	for _, a := range reply {
+		m := replyMap(a)
+		arg := CommandArg{
+			Name:    replyString(m["name"]),
+			Display: replyString(m["display_text"]),
+			Type:    replyString(m["type"]),
+			Token:   replyString(m["token"]),
+		}
+		for _, flag := range replyStrings(m["flags"]) {
+			switch flag {
+			case "optional":
+				arg.Optional = true
+			case "multiple":
+				arg.Multiple = true
+			}
+		}
+		if children, ok := m["arguments"].([]interface{}); ok {
+			arg.Args = parseCommandArgs(children)
+		}
+		args = append(args, arg)
+	}
+	return args
+}
+
+// replyMap reads a RESP3 map or a RESP2 array of alternating keys and values
+func replyMap(v interface{}) map[string]interface{} {
+	m := make(map[string]interface{})
+	switch r := v.(type) {
+	case map[interface{}]interface{}:
+		for k, val := range r {
+			m[replyString(k)] = val
+		}
+	case map[string]interface{}:
+		return r
+	case []interface{}:
+		for i := 0; i+1 < len(r); i += 2 {
+			m[replyString(r[i])] = r[i+1]
+		}
+	}
+	return m
+}
+
+// replyString reads a string reply, returning "" for anything else
+func replyString(v interface{}) string {
+	switch r := v.(type) {
+	case string:
+		return r
+	case []byte:
+		return string(r)
+	}
+	return ""
+}
+
+// replyInt reads an integer reply, returning 0 for anything else
+func replyInt(v interface{}) int {
+	if n, ok := v.(int64); ok {
+		return int(n)
+	}
+	return 0
+}
+
+// replyStrings reads an array or set of strings
+func replyStrings(v interface{}) []string {
+	var items []interface{}
+	switch r := v.(type) {
+	case []interface{}:
+		items = r
+	case map[interface{}]bool:
+		for k := range r {
+			items = append(items, k)
+		}
+	}
+	strs := make([]string, 0, len(items))
+	for _, item := range items {
+		strs = append(strs, replyString(item))
+	}
+	return strs
+}
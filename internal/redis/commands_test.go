@@ -0,0 +1,44 @@
+package redis_test
+
+import (
+	"testing"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis/redistest"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCommandTable tests parsing command info and docs
+func TestCommandTable(t *testing.T) {
+	table := redistest.CommandTable()
+
+	assert.Equal(t, []string{"CONFIG", "GET", "MGET", "SET"}, table.Names())
+	assert.True(t, table["SET"].HasFlag("write"))
+	assert.False(t, table["GET"].HasFlag("write"))
+	assert.Equal(t, "SET key value [NX | XX] [GET]", table["SET"].Syntax())
+	assert.Equal(t, "CONFIG GET parameter [parameter ...]", table.Lookup([]string{"config", "get"}).Syntax())
+	assert.Equal(t, "Returns the effective values of configuration parameters.", table.Lookup([]string{"config", "get"}).Summary)
+	assert.True(t, table.Lookup([]string{"CONFIG", "SET"}).HasFlag("admin"))
+
+	assert.True(t, table["GET"].IsKey(1))
+	assert.False(t, table["SET"].IsKey(2))
+	assert.True(t, table["MGET"].IsKey(5))
+}
+
+// TestCommandWrites tests which commands read-only mode refuses
+func TestCommandWrites(t *testing.T) {
+	table := redistest.CommandTable()
+	for name, spec := range redis.ParseCommandInfo([]interface{}{
+		[]interface{}{"eval", int64(-3), []interface{}{"noscript", "stale", "may_replicate", "movablekeys"}, int64(0), int64(0), int64(0)},
+		[]interface{}{"eval_ro", int64(-3), []interface{}{"readonly", "noscript", "stale", "movablekeys"}, int64(0), int64(0), int64(0)},
+	}) {
+		table[name] = spec
+	}
+
+	for _, args := range [][]string{{"SET", "k", "v"}, {"eval", "return 1", "0"}} {
+		assert.True(t, table.Lookup(args).Writes(), args)
+	}
+	for _, args := range [][]string{{"GET", "k"}, {"MGET", "a", "b"}, {"EVAL_RO", "return 1", "0"}, {"CONFIG", "GET", "*"}} {
+		assert.False(t, table.Lookup(args).Writes(), args)
+	}
+}
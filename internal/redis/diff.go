@@ -0,0 +1,480 @@
+package redis
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// DiffKind classifies a difference between two keyspaces
+type DiffKind string
+
+const (
+	DiffMissingOnSource DiffKind = "missing on source"
+	DiffMissingOnTarget DiffKind = "missing on target"
+	DiffType            DiffKind = "type mismatch"
+	DiffTTL             DiffKind = "TTL differs"
+	DiffValue           DiffKind = "value differs"
+)
+
+// KeyDiff is a single difference found by Compare
+type KeyDiff struct {
+	Key        string
+	Kind       DiffKind
+	SourceType string
+	TargetType string
+	SourceTTL  time.Duration // negative when the key has no expiry
+	TargetTTL  time.Duration
+}
+
+// CompareOptions controls a keyspace comparison
+type CompareOptions struct {
+	Pattern       string        // SCAN match pattern
+	BatchSize     int           // Keys per pipelined round trip
+	TTLTolerance  time.Duration // TTLs closer than this are considered equal
+	CompareValues bool          // Hash values as well as types and TTLs
+
+	// Progress is called after every batch
+	Progress func(CompareProgress)
+}
+
+// CompareProgress reports how far a comparison has got
+type CompareProgress struct {
+	SourceScanned int64
+	TargetScanned int64
+	Differences   int64
+}
+
+// String returns a one-line summary of the progress
+func (p CompareProgress) String() string {
+	return fmt.Sprintf("source %d keys, target %d keys, %d differences",
+		p.SourceScanned, p.TargetScanned, p.Differences)
+}
+
+// keyState is what Compare fetches for a key on one side
+type keyState struct {
+	keyType string
+	ttl     time.Duration
+	dump    string
+}
+
+// Compare scans both keyspaces and reports missing keys, type, TTL and value differences
+func (c *Client) Compare(ctx context.Context, target *Client, opts CompareOptions) ([]KeyDiff, error) {
+	c, release := c.pin()
+	defer release()
+	target, releaseTarget := target.pin()
+	defer releaseTarget()
+	if opts.Pattern == "" {
+		opts.Pattern = "*"
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+	if opts.TTLTolerance <= 0 {
+		opts.TTLTolerance = 2 * time.Second
+	}
+
+	var diffs []KeyDiff
+	var progress CompareProgress
+	report := func() {
+		progress.Differences = int64(len(diffs))
+		if opts.Progress != nil {
+			opts.Progress(progress)
+		}
+	}
+
+	// Pass 1: every source key is looked up on the target
+	var cursor uint64
+	for {
+		keys, next, err := c.db().Scan(ctx, cursor, opts.Pattern, int64(opts.BatchSize)).Result()
+		if err != nil {
+			return diffs, fmt.Errorf("failed to scan source: %w", err)
+		}
+		progress.SourceScanned += int64(len(keys))
+
+		if len(keys) > 0 {
+			batch, err := c.compareBatch(ctx, target, keys, opts)
+			if err != nil {
+				return diffs, err
+			}
+			diffs = append(diffs, batch...)
+		}
+
+		report()
+		if cursor = next; cursor == 0 {
+			break
+		}
+	}
+
+	// Pass 2: target keys that do not exist on the source
+	for {
+		keys, next, err := target.db().Scan(ctx, cursor, opts.Pattern, int64(opts.BatchSize)).Result()
+		if err != nil {
+			return diffs, fmt.Errorf("failed to scan target: %w", err)
+		}
+		progress.TargetScanned += int64(len(keys))
+
+		if len(keys) > 0 {
+			exists := make([]*redis.IntCmd, len(keys))
+			_, err := c.db().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+				for i, key := range keys {
+					exists[i] = pipe.Exists(ctx, key)
+				}
+				return nil
+			})
+			if err != nil {
+				return diffs, fmt.Errorf("failed to check source keys: %w", err)
+			}
+
+			for i, key := range keys {
+				if exists[i].Val() == 0 {
+					diffs = append(diffs, KeyDiff{Key: key, Kind: DiffMissingOnSource, SourceType: "none", SourceTTL: -1})
+				}
+			}
+		}
+
+		report()
+		if cursor = next; cursor == 0 {
+			break
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].Key < diffs[j].Key
+	})
+	return diffs, nil
+}
+
+// compareBatch compares one batch of source keys against the target
+func (c *Client) compareBatch(ctx context.Context, target *Client, keys []string, opts CompareOptions) ([]KeyDiff, error) {
+	source, err := c.fetchKeyStates(ctx, keys, opts.CompareValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source keys: %w", err)
+	}
+	dest, err := target.fetchKeyStates(ctx, keys, opts.CompareValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read target keys: %w", err)
+	}
+
+	var diffs []KeyDiff
+	for i, key := range keys {
+		s, t := source[i], dest[i]
+		if s.keyType == "none" {
+			// Expired or deleted since SCAN returned it
+			continue
+		}
+
+		base := KeyDiff{Key: key, SourceType: s.keyType, TargetType: t.keyType, SourceTTL: s.ttl, TargetTTL: t.ttl}
+
+		if t.keyType == "none" {
+			base.Kind = DiffMissingOnTarget
+			diffs = append(diffs, base)
+			continue
+		}
+		if s.keyType != t.keyType {
+			base.Kind = DiffType
+			diffs = append(diffs, base)
+			continue
+		}
+		if ttlDiffers(s.ttl, t.ttl, opts.TTLTolerance) {
+			d := base
+			d.Kind = DiffTTL
+			diffs = append(diffs, d)
+		}
+		if opts.CompareValues && s.dump != t.dump {
+			// DUMP payloads differ across encodings and RDB versions, so confirm with a content hash
+			sourceDigest, err := c.ValueDigest(key, s.keyType)
+			if kind, changed := changedKeyDiff(err, DiffMissingOnSource); changed {
+				d := base
+				d.Kind = kind
+				diffs = append(diffs, d)
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			targetDigest, err := target.ValueDigest(key, t.keyType)
+			if kind, changed := changedKeyDiff(err, DiffMissingOnTarget); changed {
+				d := base
+				d.Kind = kind
+				diffs = append(diffs, d)
+				continue
+			} else if err != nil {
+				return nil, err
+			}
+			if sourceDigest != targetDigest {
+				d := base
+				d.Kind = DiffValue
+				diffs = append(diffs, d)
+			}
+		}
+	}
+
+	return diffs, nil
+}
+
+// changedKeyDiff classifies a digest error caused by a key that expired or changed type since
+// its type was read, which is recorded as a difference instead of stopping the comparison
+func changedKeyDiff(err error, missing DiffKind) (DiffKind, bool) {
+	if err == nil {
+		return "", false
+	}
+	if errors.Is(err, redis.Nil) {
+		return missing, true
+	}
+	var replyErr redis.Error
+	if errors.As(err, &replyErr) && strings.HasPrefix(replyErr.Error(), "WRONGTYPE") {
+		return DiffType, true
+	}
+	return "", false
+}
+
+// fetchKeyStates reads type, TTL and optionally a DUMP digest for each key in one pipeline
+func (c *Client) fetchKeyStates(ctx context.Context, keys []string, withDump bool) ([]keyState, error) {
+	types := make([]*redis.StatusCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+	dumps := make([]*redis.StringCmd, len(keys))
+
+	_, err := c.db().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			types[i] = pipe.Type(ctx, key)
+			ttls[i] = pipe.PTTL(ctx, key)
+			if withDump {
+				dumps[i] = pipe.Dump(ctx, key)
+			}
+		}
+		return nil
+	})
+	if _, isReply := err.(redis.Error); err != nil && !isReply {
+		return nil, err
+	}
+
+	states := make([]keyState, len(keys))
+	for i := range keys {
+		states[i].keyType = types[i].Val()
+		if states[i].keyType == "" {
+			states[i].keyType = "none"
+		}
+		states[i].ttl = ttls[i].Val()
+		if withDump {
+			sum := sha256.Sum256([]byte(dumps[i].Val()))
+			states[i].dump = hex.EncodeToString(sum[:])
+		}
+	}
+	return states, nil
+}
+
+// ttlDiffers reports whether two TTLs differ by more than the tolerance
+func ttlDiffers(a, b, tolerance time.Duration) bool {
+	if a < 0 || b < 0 {
+		return (a < 0) != (b < 0)
+	}
+	delta := a - b
+	if delta < 0 {
+		delta = -delta
+	}
+	return delta > tolerance
+}
+
+// digestBatch is how many elements ValueDigest reads per command
+const digestBatch = 1000
+
+// ValueDigest returns a hash of the raw bytes of a key's content that does not depend on its
+// encoding. Collections are read in batches; set members, hash fields and sorted set members
+// are hashed one by one and their sorted hashes combined, so the server's order does not matter.
+// A string that no longer exists returns an error matching redis.Nil.
+func (c *Client) ValueDigest(key, keyType string) (string, error) {
+	rdb := c.db()
+	sum := sha256.New()
+	writeDigestParts(sum, keyType)
+
+	switch keyType {
+	case "string":
+		value, err := rdb.Get(c.ctx, key).Result()
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+		writeDigestParts(sum, value)
+	case "list":
+		for start := int64(0); ; start += digestBatch {
+			items, err := rdb.LRange(c.ctx, key, start, start+digestBatch-1).Result()
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", key, err)
+			}
+			for _, item := range items {
+				writeDigestParts(sum, item)
+			}
+			if len(items) < digestBatch {
+				break
+			}
+		}
+	case "stream":
+		if err := c.digestStream(sum, key); err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+	case "set", "hash", "zset":
+		elements, err := c.elementDigests(key, keyType)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", key, err)
+		}
+		for _, element := range elements {
+			sum.Write(element[:])
+		}
+	default:
+		return "", fmt.Errorf("%s: cannot compare values of type %s", key, keyType)
+	}
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
+
+// digestStream hashes stream entries in ID order, with their fields in their stored order
+func (c *Client) digestStream(sum hash.Hash, key string) error {
+	start := "-"
+	for {
+		reply, err := c.db().Do(c.ctx, "XRANGE", key, start, "+", "COUNT", digestBatch).Slice()
+		if err != nil {
+			return err
+		}
+		var lastID string
+		for _, item := range reply {
+			entry, ok := item.([]interface{})
+			if !ok || len(entry) < 2 {
+				continue
+			}
+			lastID = replyString(entry[0])
+			writeDigestParts(sum, append([]string{lastID}, replyStrings(entry[1])...)...)
+		}
+		if len(reply) < digestBatch || lastID == "" {
+			return nil
+		}
+		start = nextStreamID(lastID)
+	}
+}
+
+// nextStreamID returns the smallest stream ID after id, for paging XRANGE on servers
+// without exclusive ranges
+func nextStreamID(id string) string {
+	ms, seq, ok := strings.Cut(id, "-")
+	if !ok {
+		return id
+	}
+	n, err := strconv.ParseUint(seq, 10, 64)
+	if err != nil {
+		return id
+	}
+	return fmt.Sprintf("%s-%d", ms, n+1)
+}
+
+// elementDigests hashes every member of a set, or field and value of a hash, or member and
+// score of a sorted set, read with SSCAN, HSCAN or ZSCAN, and returns the hashes sorted.
+// Elements SCAN returns twice are counted once.
+func (c *Client) elementDigests(key, keyType string) ([][sha256.Size]byte, error) {
+	seen := make(map[[sha256.Size]byte]bool)
+	var cursor uint64
+	for {
+		var items []string
+		var err error
+		step := 2
+		switch keyType {
+		case "set":
+			items, cursor, err = c.db().SScan(c.ctx, key, cursor, "", digestBatch).Result()
+			step = 1
+		case "hash":
+			items, cursor, err = c.db().HScan(c.ctx, key, cursor, "", digestBatch).Result()
+		case "zset":
+			items, cursor, err = c.db().ZScan(c.ctx, key, cursor, "", digestBatch).Result()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for i := 0; i+step <= len(items); i += step {
+			parts := items[i : i+step]
+			if keyType == "zset" {
+				parts = []string{parts[0], canonicalScore(parts[1])}
+			}
+			h := sha256.New()
+			writeDigestParts(h, parts...)
+			var element [sha256.Size]byte
+			copy(element[:], h.Sum(nil))
+			seen[element] = true
+		}
+		if cursor == 0 {
+			break
+		}
+	}
+
+	elements := make([][sha256.Size]byte, 0, len(seen))
+	for element := range seen {
+		elements = append(elements, element)
+	}
+	sort.Slice(elements, func(i, j int) bool {
+		return bytes.Compare(elements[i][:], elements[j][:]) < 0
+	})
+	return elements, nil
+}
+
+// canonicalScore formats a sorted set score the same way whatever the server sent
+func canonicalScore(score string) string {
+	f, err := strconv.ParseFloat(score, 64)
+	if err != nil {
+		return score
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// writeDigestParts writes each part's length and raw bytes, so parts cannot run into each other
+func writeDigestParts(h hash.Hash, parts ...string) {
+	var size [8]byte
+	for _, part := range parts {
+		binary.BigEndian.PutUint64(size[:], uint64(len(part)))
+		h.Write(size[:])
+		h.Write([]byte(part))
+	}
+}
+
+// CanonicalValue returns the key's value as indented JSON with unordered collections sorted
+func (c *Client) CanonicalValue(key string) (string, error) {
+	keyType, err := c.db().Type(c.ctx, key).Result()
+	if err != nil {
+		return "", err
+	}
+	if keyType == "none" {
+		return "(key does not exist)", nil
+	}
+
+	value, err := c.canonicalValue(key, keyType)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// canonicalValue reads a typed value and sorts sets so equal content compares equal
+func (c *Client) canonicalValue(key, keyType string) (interface{}, error) {
+	value, err := c.readTypedValue(key, keyType)
+	if err != nil {
+		return nil, err
+	}
+
+	// Hash fields are sorted and lists, sorted sets and streams are ordered already
+	if members, ok := value.([]Blob); ok && keyType == "set" {
+		sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+	}
+	return value, nil
+}
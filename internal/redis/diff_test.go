@@ -0,0 +1,71 @@
+package redis
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// digestOf hashes parts the way ValueDigest hashes an element
+func digestOf(parts ...string) string {
+	h := sha256.New()
+	writeDigestParts(h, parts...)
+	return fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// TestWriteDigestParts tests that digests see raw bytes and part boundaries
+func TestWriteDigestParts(t *testing.T) {
+	assert.NotEqual(t, digestOf("\xff"), digestOf("\xfe"))
+	assert.NotEqual(t, digestOf("ab", "c"), digestOf("a", "bc"))
+	assert.NotEqual(t, digestOf(""), digestOf())
+	assert.Equal(t, digestOf("field", "\x00\x01"), digestOf("field", "\x00\x01"))
+}
+
+// TestNextStreamID tests paging stream IDs
+func TestNextStreamID(t *testing.T) {
+	assert.Equal(t, "1700000000000-1", nextStreamID("1700000000000-0"))
+	assert.Equal(t, "5-10", nextStreamID("5-9"))
+	assert.Equal(t, "bad", nextStreamID("bad"))
+}
+
+// TestCanonicalScore tests that equal scores format the same
+func TestCanonicalScore(t *testing.T) {
+	assert.Equal(t, "1", canonicalScore("1"))
+	assert.Equal(t, "1", canonicalScore("1.0"))
+	assert.Equal(t, "0.5", canonicalScore("0.50000000000000000"))
+	assert.Equal(t, "+Inf", canonicalScore("inf"))
+}
+
+// serverError is an error reply as go-redis returns it
+type serverError string
+
+func (e serverError) Error() string { return string(e) }
+func (e serverError) RedisError()   {}
+
+// TestChangedKeyDiff tests which digest errors become differences
+func TestChangedKeyDiff(t *testing.T) {
+	kind, changed := changedKeyDiff(fmt.Errorf("k: %w", redis.Nil), DiffMissingOnTarget)
+	assert.True(t, changed)
+	assert.Equal(t, DiffMissingOnTarget, kind)
+
+	kind, changed = changedKeyDiff(serverError("WRONGTYPE Operation against a key holding the wrong kind of value"), DiffMissingOnSource)
+	assert.True(t, changed)
+	assert.Equal(t, DiffType, kind)
+
+	_, changed = changedKeyDiff(fmt.Errorf("dial tcp: connection refused"), DiffMissingOnSource)
+	assert.False(t, changed)
+	_, changed = changedKeyDiff(nil, DiffMissingOnSource)
+	assert.False(t, changed)
+}
+
+// TestTTLDiffers tests the TTL tolerance
+func TestTTLDiffers(t *testing.T) {
+	assert.False(t, ttlDiffers(-1, -1, time.Second))
+	assert.True(t, ttlDiffers(-1, 10*time.Second, time.Second))
+	assert.False(t, ttlDiffers(10*time.Second, 10500*time.Millisecond, time.Second))
+	assert.True(t, ttlDiffers(10*time.Second, 12*time.Second, time.Second))
+}
@@ -0,0 +1,50 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ErrorStat is the number of error replies of one type from INFO errorstats
+type ErrorStat struct {
+	Type  string // Error prefix such as ERR, WRONGTYPE or NOPERM
+	Count int64
+}
+
+// GetErrorStats returns the error reply counters of INFO errorstats (Redis 6.2+ and Valkey)
+func (c *Client) GetErrorStats() ([]ErrorStat, error) {
+	info, err := c.db().Info(c.ctx, "errorstats").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get error stats: %w", err)
+	}
+	return ParseErrorStats(info), nil
+}
+
+// ParseErrorStats parses lines like errorstat_WRONGTYPE:count=12, sorted by type
+func ParseErrorStats(info string) []ErrorStat {
+	var stats []ErrorStat
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "errorstat_") {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(line, "errorstat_"), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+
+		stat := ErrorStat{Type: parts[0]}
+		for _, pair := range strings.Split(parts[1], ",") {
+			if kv := strings.SplitN(pair, "=", 2); len(kv) == 2 && kv[0] == "count" {
+				stat.Count, _ = strconv.ParseInt(kv[1], 10, 64)
+			}
+		}
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].Type < stats[j].Type })
+	return stats
+}
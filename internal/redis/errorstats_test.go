@@ -0,0 +1,29 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseErrorStats tests parsing INFO errorstats into counters sorted by type
+func TestParseErrorStats(t *testing.T) {
+	info := "# Errorstats\r\n" +
+		"errorstat_WRONGTYPE:count=12\r\n" +
+		"errorstat_ERR:count=3\r\n" +
+		"errorstat_NOPERM:count=1\r\n" +
+		"errorstat_BUSYKEY:count=bad\r\n" +
+		"errorstat_broken\r\n" +
+		"total_error_replies:16\r\n"
+
+	assert.Equal(t, []ErrorStat{
+		{Type: "BUSYKEY", Count: 0},
+		{Type: "ERR", Count: 3},
+		{Type: "NOPERM", Count: 1},
+		{Type: "WRONGTYPE", Count: 12},
+	}, ParseErrorStats(info))
+
+	// Servers before Redis 6.2 have an empty or unknown section
+	assert.Empty(t, ParseErrorStats(""))
+	assert.Empty(t, ParseErrorStats("# Errorstats\r\n"))
+}
@@ -0,0 +1,278 @@
+package redis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+)
+
+// DangerClass is a kind of command that asks for confirmation before it runs
+type DangerClass string
+
+// Danger classes
+const (
+	DangerDestructive DangerClass = "destructive" // Deletes data in bulk
+	DangerBlocking    DangerClass = "blocking"    // Blocks the whole server
+	DangerSlow        DangerClass = "O(N)"        // Slow on the keyspace or on large keys
+	DangerAdmin       DangerClass = "admin"       // Changes how the server runs
+)
+
+// defaultLargeKey is the element count from which O(N) key commands are confirmed
+const defaultLargeKey = 10000
+
+// defaultDangerous lists the built-in commands of each class; a subcommand entry
+// takes precedence over its container, e.g. DEBUG SLEEP over DEBUG
+var defaultDangerous = map[DangerClass][]string{
+	DangerDestructive: {
+		"FLUSHALL", "FLUSHDB", "SWAPDB", "SCRIPT FLUSH", "FUNCTION FLUSH",
+		"CLUSTER RESET", "CLUSTER FLUSHSLOTS",
+	},
+	DangerBlocking: {
+		"SAVE", "DEBUG SLEEP", "DEBUG RELOAD", "CLIENT PAUSE",
+	},
+	DangerSlow: {
+		"KEYS", "DEL", "HGETALL", "HKEYS", "HVALS", "SMEMBERS", "SINTER", "SUNION", "SDIFF",
+		"SINTERSTORE", "SUNIONSTORE", "SDIFFSTORE", "LRANGE", "LREM", "ZRANGE", "ZREVRANGE",
+		"ZRANGEBYSCORE", "ZREVRANGEBYSCORE", "ZRANGEBYLEX", "XRANGE", "XREVRANGE", "SORT",
+	},
+	DangerAdmin: {
+		"SHUTDOWN", "DEBUG", "CONFIG SET", "CONFIG REWRITE", "CONFIG RESETSTAT", "ACL SETUSER",
+		"ACL DELUSER", "ACL LOAD", "REPLICAOF", "SLAVEOF", "MODULE LOAD", "MODULE UNLOAD",
+		"CLIENT KILL", "FAILOVER", "CLUSTER FAILOVER",
+	},
+}
+
+// dangerReasons explains each class in the confirmation
+var dangerReasons = map[DangerClass]string{
+	DangerDestructive: "it deletes data in bulk",
+	DangerBlocking:    "it blocks the server",
+	DangerSlow:        "it runs in O(N) time",
+	DangerAdmin:       "it changes how the server runs",
+}
+
+// Danger describes why a command needs confirmation
+type Danger struct {
+	Command string // Upper-case command, with its subcommand when that matched
+	Class   DangerClass
+	Reason  string
+	Confirm string // Text the user has to type: the DB number for destructive commands, else the command
+}
+
+// Guard classifies commands into danger classes
+type Guard struct {
+	disabled bool
+	classes  map[string]DangerClass
+	allow    map[string]bool
+	largeKey int64
+}
+
+// NewGuard builds the policy of a connection from the built-in lists and its profile settings
+func NewGuard(cfg config.GuardConfig) *Guard {
+	g := &Guard{
+		disabled: cfg.Disabled,
+		classes:  make(map[string]DangerClass),
+		allow:    make(map[string]bool),
+		largeKey: cfg.LargeKey,
+	}
+	if g.largeKey <= 0 {
+		g.largeKey = defaultLargeKey
+	}
+
+	add := func(class DangerClass, names []string) {
+		for _, name := range names {
+			g.classes[normalizeCommand(name)] = class
+		}
+	}
+	for class, names := range defaultDangerous {
+		add(class, names)
+	}
+	add(DangerDestructive, cfg.Destructive)
+	add(DangerBlocking, cfg.Blocking)
+	add(DangerSlow, cfg.Slow)
+	add(DangerAdmin, cfg.Admin)
+	for _, name := range cfg.Allow {
+		g.allow[normalizeCommand(name)] = true
+	}
+	return g
+}
+
+// normalizeCommand upper-cases a command name and collapses its spaces
+func normalizeCommand(name string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(name), " "))
+}
+
+// Classify returns the class of a command and the name that matched, or false for safe commands
+func (g *Guard) Classify(args []string) (DangerClass, string, bool) {
+	if g == nil || g.disabled {
+		return "", "", false
+	}
+	return g.lookup(args, true)
+}
+
+// lookup finds the class of a command, its subcommand name taking precedence; with allow set,
+// commands the profile allows are safe
+func (g *Guard) lookup(args []string, allow bool) (DangerClass, string, bool) {
+	if g == nil || len(args) == 0 {
+		return "", "", false
+	}
+	names := []string{strings.ToUpper(args[0])}
+	if len(args) > 1 {
+		names = append([]string{names[0] + " " + strings.ToUpper(args[1])}, names...)
+	}
+	for _, name := range names {
+		if allow && g.allow[name] {
+			return "", "", false
+		}
+		if class, ok := g.classes[name]; ok {
+			return class, name, true
+		}
+	}
+	return "", "", false
+}
+
+// ChecksOnServer reports whether CheckCommand has to ask the server about the command, to
+// size the keyspace or the keys of an O(N) command; callers run those checks off the UI thread
+func (c *Client) ChecksOnServer(args []string) bool {
+	class, _, ok := c.conn().guard.Classify(args)
+	if !ok || class != DangerSlow {
+		return false
+	}
+	n, bounded := boundedRange(args)
+	return !bounded || n >= c.conn().guard.largeKey
+}
+
+// CheckCommand returns why a command needs confirmation, or nil when it can run. O(N)
+// commands only need it on a keyspace or key with at least the large-key element count,
+// unless their arguments bound the range they read below that count.
+func (c *Client) CheckCommand(args []string) *Danger {
+	class, name, ok := c.conn().guard.Classify(args)
+	if !ok {
+		return nil
+	}
+
+	d := &Danger{Command: name, Class: class, Reason: dangerReasons[class], Confirm: name}
+	if class == DangerDestructive {
+		d.Confirm = strconv.Itoa(c.db().Options().DB)
+	}
+	if class == DangerSlow {
+		reason, large := c.largeTarget(args)
+		if !large {
+			return nil
+		}
+		d.Reason = reason
+	}
+	return d
+}
+
+// largeTarget reports whether an O(N) command works on the whole keyspace or a key holding
+// at least the large-key element count, and describes it
+func (c *Client) largeTarget(args []string) (string, bool) {
+	if n, bounded := boundedRange(args); bounded && n < c.conn().guard.largeKey {
+		return "", false
+	}
+	if strings.EqualFold(args[0], "KEYS") {
+		size, err := c.DBSize()
+		if err != nil {
+			return "it scans the whole keyspace", true
+		}
+		if size >= c.conn().guard.largeKey {
+			return fmt.Sprintf("it scans all %d keys of the database", size), true
+		}
+		return "", false
+	}
+
+	// Size every key argument; without COMMAND INFO assume the first argument is the key
+	var keys []string
+	table, _ := c.Commands()
+	spec := table.Lookup(args)
+	for i := 1; i < len(args); i++ {
+		isKey := i == 1
+		if spec != nil {
+			isKey = spec.IsKey(i)
+		}
+		if isKey {
+			keys = append(keys, args[i])
+		}
+	}
+	if len(keys) == 0 {
+		return dangerReasons[DangerSlow], true
+	}
+	sized, err := c.sizeKeys(c.ctx, keys)
+	if err != nil {
+		return "", false
+	}
+	for _, k := range sized {
+		if k.Type != "string" && k.Length >= c.conn().guard.largeKey {
+			return fmt.Sprintf("it runs in O(N) time and %s holds %d elements", QuoteArg(k.Key), k.Length), true
+		}
+	}
+	return "", false
+}
+
+// boundedRange returns how many elements a range read walks at most when its arguments
+// bound it, like LRANGE key 0 9, ZRANGE key -10 -1, ZRANGEBYSCORE ... LIMIT 0 10 or
+// XRANGE ... COUNT 10; ranges with a negative count or an end relative to the other
+// side of the key, like LRANGE key 0 -1, are unbounded
+func boundedRange(args []string) (int64, bool) {
+	if len(args) < 4 {
+		return 0, false
+	}
+
+	switch name := strings.ToUpper(args[0]); name {
+	case "LRANGE", "ZRANGE", "ZREVRANGE":
+		if name == "ZRANGE" && hasOption(args[4:], "BYSCORE", "BYLEX") {
+			return limitCount(args[4:])
+		}
+		start, err1 := strconv.ParseInt(args[2], 10, 64)
+		stop, err2 := strconv.ParseInt(args[3], 10, 64)
+		switch {
+		case err1 != nil || err2 != nil:
+			return 0, false
+		case start >= 0 && stop >= 0:
+			// Reading from the head walks past the skipped elements too
+			return stop + 1, true
+		case start < 0 && stop < 0:
+			return -start, true
+		}
+		return 0, false
+	case "ZRANGEBYSCORE", "ZREVRANGEBYSCORE", "ZRANGEBYLEX", "ZREVRANGEBYLEX":
+		return limitCount(args[4:])
+	case "XRANGE", "XREVRANGE":
+		for i := 4; i+1 < len(args); i++ {
+			if strings.EqualFold(args[i], "COUNT") {
+				n, err := strconv.ParseInt(args[i+1], 10, 64)
+				return n, err == nil && n >= 0
+			}
+		}
+	}
+	return 0, false
+}
+
+// limitCount returns offset+count of a LIMIT offset count option, when the count is not negative
+func limitCount(opts []string) (int64, bool) {
+	for i := 0; i+2 < len(opts); i++ {
+		if strings.EqualFold(opts[i], "LIMIT") {
+			offset, err1 := strconv.ParseInt(opts[i+1], 10, 64)
+			count, err2 := strconv.ParseInt(opts[i+2], 10, 64)
+			if err1 != nil || err2 != nil || offset < 0 || count < 0 {
+				return 0, false
+			}
+			return offset + count, true
+		}
+	}
+	return 0, false
+}
+
+// hasOption reports whether any of names appears among a command's options
+func hasOption(opts []string, names ...string) bool {
+	for _, opt := range opts {
+		for _, name := range names {
+			if strings.EqualFold(opt, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
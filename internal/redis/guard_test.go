@@ -0,0 +1,90 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestGuardClassify tests the built-in danger classes and their per-profile overrides
+func TestGuardClassify(t *testing.T) {
+	guard := NewGuard(config.GuardConfig{
+		Destructive: []string{"del"},
+		Admin:       []string{"client  no-evict"},
+		Allow:       []string{"CONFIG SET"},
+	})
+
+	tests := []struct {
+		args  []string
+		class DangerClass
+		name  string
+	}{
+		{[]string{"flushdb"}, DangerDestructive, "FLUSHDB"},
+		{[]string{"DEBUG", "SLEEP", "1"}, DangerBlocking, "DEBUG SLEEP"},
+		{[]string{"debug", "object", "k"}, DangerAdmin, "DEBUG"},
+		{[]string{"KEYS", "*"}, DangerSlow, "KEYS"},
+		{[]string{"DEL", "k"}, DangerDestructive, "DEL"},
+		{[]string{"CLIENT", "NO-EVICT", "on"}, DangerAdmin, "CLIENT NO-EVICT"},
+	}
+	for _, tt := range tests {
+		class, name, ok := guard.Classify(tt.args)
+		assert.True(t, ok, tt.args)
+		assert.Equal(t, tt.class, class, tt.args)
+		assert.Equal(t, tt.name, name, tt.args)
+	}
+
+	for _, args := range [][]string{{"GET", "k"}, {"CONFIG", "SET", "maxmemory", "1gb"}, {"CONFIG", "GET", "*"}, nil} {
+		_, _, ok := guard.Classify(args)
+		assert.False(t, ok, args)
+	}
+
+	_, _, ok := NewGuard(config.GuardConfig{Disabled: true}).Classify([]string{"FLUSHALL"})
+	assert.False(t, ok)
+}
+
+// TestBoundedRange tests which range reads are bounded by their arguments
+func TestBoundedRange(t *testing.T) {
+	tests := []struct {
+		args    []string
+		n       int64
+		bounded bool
+	}{
+		{[]string{"LRANGE", "big", "0", "9"}, 10, true},
+		{[]string{"lrange", "big", "-10", "-1"}, 10, true},
+		{[]string{"LRANGE", "big", "5000", "5009"}, 5010, true},
+		{[]string{"LRANGE", "big", "0", "-1"}, 0, false},
+		{[]string{"LRANGE", "big", "a", "9"}, 0, false},
+		{[]string{"ZRANGE", "z", "0", "99", "WITHSCORES"}, 100, true},
+		{[]string{"ZRANGE", "z", "0", "+inf", "BYSCORE"}, 0, false},
+		{[]string{"ZRANGE", "z", "0", "+inf", "BYSCORE", "LIMIT", "20", "10"}, 30, true},
+		{[]string{"ZRANGEBYSCORE", "z", "-inf", "+inf", "LIMIT", "0", "-1"}, 0, false},
+		{[]string{"ZREVRANGEBYSCORE", "z", "+inf", "-inf", "WITHSCORES", "LIMIT", "0", "5"}, 5, true},
+		{[]string{"XRANGE", "s", "-", "+", "COUNT", "10"}, 10, true},
+		{[]string{"XREVRANGE", "s", "+", "-"}, 0, false},
+		{[]string{"HGETALL", "h"}, 0, false},
+		{[]string{"SORT", "l", "LIMIT", "0", "10"}, 0, false},
+	}
+	for _, tt := range tests {
+		n, bounded := boundedRange(tt.args)
+		assert.Equal(t, tt.bounded, bounded, tt.args)
+		assert.Equal(t, tt.n, n, tt.args)
+	}
+}
+
+// TestChecksOnServer tests which commands need the server to decide on a confirmation
+func TestChecksOnServer(t *testing.T) {
+	c := newOfflineClient(nil, false)
+	c.conn().guard = NewGuard(config.GuardConfig{LargeKey: 100})
+
+	assert.True(t, c.ChecksOnServer([]string{"KEYS", "*"}))
+	assert.True(t, c.ChecksOnServer([]string{"HGETALL", "h"}))
+	assert.True(t, c.ChecksOnServer([]string{"LRANGE", "big", "0", "-1"}))
+	assert.True(t, c.ChecksOnServer([]string{"LRANGE", "big", "0", "99"}))
+	assert.False(t, c.ChecksOnServer([]string{"LRANGE", "big", "0", "9"}))
+	assert.False(t, c.ChecksOnServer([]string{"FLUSHALL"}))
+	assert.False(t, c.ChecksOnServer([]string{"GET", "k"}))
+
+	assert.Nil(t, c.CheckCommand([]string{"LRANGE", "big", "0", "9"}))
+	assert.NotNil(t, c.CheckCommand([]string{"FLUSHALL"}))
+}
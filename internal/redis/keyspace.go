@@ -0,0 +1,66 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// KeyspaceStat is one database line of INFO keyspace
+type KeyspaceStat struct {
+	DB      int
+	Keys    int64
+	Expires int64 // Keys with a TTL
+	AvgTTL  int64 // Average TTL in milliseconds
+}
+
+// GetKeyspaceStats returns the key counts of every non-empty database
+func (c *Client) GetKeyspaceStats() ([]KeyspaceStat, error) {
+	info, err := c.db().Info(c.ctx, "keyspace").Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get keyspace stats: %w", err)
+	}
+	return ParseKeyspaceStats(info), nil
+}
+
+// ParseKeyspaceStats parses lines like db0:keys=10,expires=2,avg_ttl=3600, sorted by database
+func ParseKeyspaceStats(info string) []KeyspaceStat {
+	var stats []KeyspaceStat
+	for _, line := range strings.Split(info, "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "db") {
+			continue
+		}
+
+		parts := strings.SplitN(strings.TrimPrefix(line, "db"), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		db, err := strconv.Atoi(parts[0])
+		if err != nil {
+			continue
+		}
+
+		stat := KeyspaceStat{DB: db}
+		for _, pair := range strings.Split(parts[1], ",") {
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) != 2 {
+				continue
+			}
+			val, _ := strconv.ParseInt(kv[1], 10, 64)
+			switch kv[0] {
+			case "keys":
+				stat.Keys = val
+			case "expires":
+				stat.Expires = val
+			case "avg_ttl":
+				stat.AvgTTL = val
+			}
+		}
+		stats = append(stats, stat)
+	}
+
+	sort.Slice(stats, func(i, j int) bool { return stats[i].DB < stats[j].DB })
+	return stats
+}
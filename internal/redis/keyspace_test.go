@@ -0,0 +1,26 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseKeyspaceStats tests parsing INFO keyspace into databases sorted by number
+func TestParseKeyspaceStats(t *testing.T) {
+	info := "# Keyspace\r\n" +
+		"db10:keys=5,expires=0,avg_ttl=0\r\n" +
+		"db0:keys=1200,expires=30,avg_ttl=86399871\r\n" +
+		"db2:keys=7,expires=7,avg_ttl=1500,subexpiry=2\r\n" +
+		"dbx:keys=1,expires=0,avg_ttl=0\r\n" +
+		"db3\r\n"
+
+	assert.Equal(t, []KeyspaceStat{
+		{DB: 0, Keys: 1200, Expires: 30, AvgTTL: 86399871},
+		{DB: 2, Keys: 7, Expires: 7, AvgTTL: 1500},
+		{DB: 10, Keys: 5},
+	}, ParseKeyspaceStats(info))
+
+	// An empty server lists no databases
+	assert.Empty(t, ParseKeyspaceStats("# Keyspace\r\n"))
+}
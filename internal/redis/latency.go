@@ -0,0 +1,105 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// LatencyEvent is one event reported by LATENCY LATEST
+type LatencyEvent struct {
+	Name      string
+	LastSpike time.Time
+	Latest    time.Duration
+	Max       time.Duration
+}
+
+// LatencySample is one point of LATENCY HISTORY
+type LatencySample struct {
+	Time    time.Time
+	Latency time.Duration
+}
+
+// GetLatencyLatest returns the latest spike of every latency event, sorted by name
+func (c *Client) GetLatencyLatest() ([]LatencyEvent, error) {
+	reply, err := c.db().Do(c.ctx, "LATENCY", "LATEST").Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latency events: %w", err)
+	}
+
+	var events []LatencyEvent
+	for _, item := range reply {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 4 {
+			continue
+		}
+		name, _ := fields[0].(string)
+		events = append(events, LatencyEvent{
+			Name:      name,
+			LastSpike: time.Unix(toInt64(fields[1]), 0),
+			Latest:    time.Duration(toInt64(fields[2])) * time.Millisecond,
+			Max:       time.Duration(toInt64(fields[3])) * time.Millisecond,
+		})
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Name < events[j].Name
+	})
+	return events, nil
+}
+
+// GetLatencyHistory returns the recorded spikes of an event, oldest first
+func (c *Client) GetLatencyHistory(event string) ([]LatencySample, error) {
+	reply, err := c.db().Do(c.ctx, "LATENCY", "HISTORY", event).Slice()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get latency history: %w", err)
+	}
+
+	var samples []LatencySample
+	for _, item := range reply {
+		fields, ok := item.([]interface{})
+		if !ok || len(fields) < 2 {
+			continue
+		}
+		samples = append(samples, LatencySample{
+			Time:    time.Unix(toInt64(fields[0]), 0),
+			Latency: time.Duration(toInt64(fields[1])) * time.Millisecond,
+		})
+	}
+	return samples, nil
+}
+
+// LatencyDoctor returns the human readable LATENCY DOCTOR report
+func (c *Client) LatencyDoctor() (string, error) {
+	report, err := c.db().Do(c.ctx, "LATENCY", "DOCTOR").Text()
+	if err != nil {
+		return "", fmt.Errorf("failed to run latency doctor: %w", err)
+	}
+	return report, nil
+}
+
+// ResetLatency clears the history of the given events, or of all events when none are given
+func (c *Client) ResetLatency(events ...string) (int64, error) {
+	args := []interface{}{"LATENCY", "RESET"}
+	for _, e := range events {
+		args = append(args, e)
+	}
+	n, err := c.db().Do(c.ctx, args...).Int64()
+	if err != nil {
+		return 0, fmt.Errorf("failed to reset latency events: %w", err)
+	}
+	return n, nil
+}
+
+// toInt64 converts an integer reply element
+func toInt64(v interface{}) int64 {
+	switch n := v.(type) {
+	case int64:
+		return n
+	case string:
+		var i int64
+		fmt.Sscan(n, &i)
+		return i
+	}
+	return 0
+}
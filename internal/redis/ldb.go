@@ -0,0 +1,161 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+)
+
+// debugEndMark is the last log line of a debugging session
+const debugEndMark = "<endsession>"
+
+// DebugSession is a Lua debugging session started with SCRIPT DEBUG on a dedicated
+// connection; the server stops at the first line and waits for LDB commands
+type DebugSession struct {
+	conn *rawConn
+	sync bool
+
+	ended  bool
+	result interface{}
+	err    error
+}
+
+// StartDebug enables SCRIPT DEBUG on a new connection and runs the script with EVAL. In the
+// default forked mode the server debugs a copy of itself and discards every write; sync
+// blocks the server for the whole session and keeps the script's changes. It returns the
+// first debugger log lines. Read-only clients only debug in forked mode.
+func (c *Client) StartDebug(ctx context.Context, script string, keys, args []string, sync bool) (*DebugSession, []string, error) {
+	if sync && c.conn().readOnly {
+		return nil, nil, fmt.Errorf("%w: SCRIPT DEBUG SYNC keeps the script's writes", ErrReadOnly)
+	}
+	// The debugger's log replies are read as RESP2 arrays of status lines
+	conn, err := c.dialRaw(ctx, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mode := "YES"
+	if sync {
+		mode = "SYNC"
+	}
+	if _, err := conn.Do("SCRIPT", "DEBUG", mode); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to enable script debugging: %w", err)
+	}
+
+	cmdArgs := make([]interface{}, 0, 3+len(keys)+len(args))
+	cmdArgs = append(cmdArgs, "EVAL", script, len(keys))
+	cmdArgs = append(cmdArgs, stringArgs(keys)...)
+	cmdArgs = append(cmdArgs, stringArgs(args)...)
+	if err := conn.Send(cmdArgs...); err != nil {
+		conn.Close()
+		return nil, nil, fmt.Errorf("failed to send EVAL: %w", err)
+	}
+
+	s := &DebugSession{conn: conn, sync: sync}
+	logs, err := s.readLogs()
+	if err != nil {
+		return nil, nil, err
+	}
+	return s, logs, nil
+}
+
+// Command sends an LDB command such as step, continue, break 12 or print and returns its log lines
+func (s *DebugSession) Command(args ...string) ([]string, error) {
+	if s.ended {
+		return nil, fmt.Errorf("the debugging session has ended")
+	}
+	if err := s.conn.Send(stringArgs(args)...); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("failed to send debugger command: %w", err)
+	}
+	return s.readLogs()
+}
+
+// readLogs reads the log lines of one debugger step; after the end mark the server sends
+// the script's own reply, which ends the session
+func (s *DebugSession) readLogs() ([]string, error) {
+	reply, err := s.conn.ReadReply()
+	if err != nil {
+		var replyErr ReplyError
+		if errors.As(err, &replyErr) {
+			// The script did not compile, or failed before the debugger stopped
+			s.end(nil, replyErr)
+			return nil, nil
+		}
+		s.Close()
+		return nil, fmt.Errorf("failed to read debugger reply: %w", err)
+	}
+
+	items, ok := reply.([]interface{})
+	if !ok {
+		s.end(reply, nil)
+		return nil, nil
+	}
+	logs := make([]string, 0, len(items))
+	ended := false
+	for _, item := range items {
+		line := replyString(item)
+		if line == debugEndMark {
+			ended = true
+			continue
+		}
+		logs = append(logs, line)
+	}
+	if ended {
+		result, err := s.conn.ReadReply()
+		var replyErr ReplyError
+		if err != nil && !errors.As(err, &replyErr) {
+			// The forked server may exit before the reply is read
+			err = nil
+		}
+		s.end(result, err)
+	}
+	return logs, nil
+}
+
+// end records the script's reply and closes the connection
+func (s *DebugSession) end(result interface{}, err error) {
+	s.ended, s.result, s.err = true, result, err
+	s.Close()
+}
+
+// Ended reports whether the script finished or was aborted
+func (s *DebugSession) Ended() bool {
+	return s.ended
+}
+
+// Result returns the script's reply once the session has ended
+func (s *DebugSession) Result() (interface{}, error) {
+	return s.result, s.err
+}
+
+// Sync reports whether the session blocks the server
+func (s *DebugSession) Sync() bool {
+	return s.sync
+}
+
+// Close ends the session by closing its connection; a forked debugger exits with it
+func (s *DebugSession) Close() error {
+	if err := s.conn.Close(); err != nil && !errors.Is(err, net.ErrClosed) {
+		return err
+	}
+	return nil
+}
+
+// debugStopPattern matches the log line naming where the debugger stopped
+var debugStopPattern = regexp.MustCompile(`^\* Stopped at (\d+)`)
+
+// DebugStoppedAt returns the script line the debugger stopped at according to its logs, or 0
+func DebugStoppedAt(logs []string) int {
+	for _, line := range logs {
+		if m := debugStopPattern.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			return n
+		}
+	}
+	return 0
+}
@@ -0,0 +1,204 @@
+package redis
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FunctionLibrary is a library of Redis 7 functions from FUNCTION LIST
+type FunctionLibrary struct {
+	Name      string
+	Engine    string
+	Functions []LibraryFunction
+	Code      string // Only filled in by FUNCTION LIST WITHCODE
+}
+
+// LibraryFunction is one function registered by a library
+type LibraryFunction struct {
+	Name        string
+	Description string
+	Flags       []string // e.g. no-writes, allow-oom
+}
+
+// Function restore policies of FUNCTION RESTORE
+const (
+	RestoreAppend  = "APPEND"
+	RestoreReplace = "REPLACE"
+	RestoreFlush   = "FLUSH"
+)
+
+// ScriptSHA returns the SHA1 digest the server uses to identify a script
+func ScriptSHA(script string) string {
+	sum := sha1.Sum([]byte(script))
+	return hex.EncodeToString(sum[:])
+}
+
+// Eval runs a Lua script with EVAL, or EVAL_RO when readOnly is set
+func (c *Client) Eval(script string, keys, args []string, readOnly bool) (interface{}, error) {
+	cmd := "EVAL"
+	if readOnly {
+		cmd = "EVAL_RO"
+	}
+	return c.callScript(cmd, script, keys, args)
+}
+
+// EvalSHA runs a script cached by SCRIPT LOAD with EVALSHA, or EVALSHA_RO when readOnly is set
+func (c *Client) EvalSHA(sha string, keys, args []string, readOnly bool) (interface{}, error) {
+	cmd := "EVALSHA"
+	if readOnly {
+		cmd = "EVALSHA_RO"
+	}
+	return c.callScript(cmd, sha, keys, args)
+}
+
+// FCall calls a function with FCALL, or FCALL_RO when readOnly is set
+func (c *Client) FCall(function string, keys, args []string, readOnly bool) (interface{}, error) {
+	cmd := "FCALL"
+	if readOnly {
+		cmd = "FCALL_RO"
+	}
+	return c.callScript(cmd, function, keys, args)
+}
+
+// callScript sends a command shaped like EVAL: the script or function, the key count, keys and arguments
+func (c *Client) callScript(cmd, target string, keys, args []string) (interface{}, error) {
+	cmdArgs := make([]interface{}, 0, 3+len(keys)+len(args))
+	cmdArgs = append(cmdArgs, cmd, target, len(keys))
+	cmdArgs = append(cmdArgs, stringArgs(keys)...)
+	cmdArgs = append(cmdArgs, stringArgs(args)...)
+	reply, err := c.db().Do(c.ctx, cmdArgs...).Result()
+	return reply, nilReply(err)
+}
+
+// ScriptLoad caches a script on the server and returns its SHA1 digest
+func (c *Client) ScriptLoad(script string) (string, error) {
+	sha, err := c.db().ScriptLoad(c.ctx, script).Result()
+	if err != nil {
+		return "", fmt.Errorf("failed to load script: %w", err)
+	}
+	return sha, nil
+}
+
+// ScriptExists reports which of the digests are in the server's script cache
+func (c *Client) ScriptExists(shas ...string) ([]bool, error) {
+	exists, err := c.db().ScriptExists(c.ctx, shas...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check scripts: %w", err)
+	}
+	return exists, nil
+}
+
+// ScriptFlush empties the server's script cache
+func (c *Client) ScriptFlush() error {
+	if err := c.db().ScriptFlush(c.ctx).Err(); err != nil {
+		return fmt.Errorf("failed to flush scripts: %w", err)
+	}
+	return nil
+}
+
+// FunctionList returns the function libraries sorted by name, with their code when withCode is set
+func (c *Client) FunctionList(withCode bool) ([]FunctionLibrary, error) {
+	args := []interface{}{"FUNCTION", "LIST"}
+	if withCode {
+		args = append(args, "WITHCODE")
+	}
+	reply, err := c.db().Do(c.ctx, args...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list functions: %w", err)
+	}
+	return ParseFunctionList(reply), nil
+}
+
+// ParseFunctionList parses a RESP2 or RESP3 FUNCTION LIST reply
+func ParseFunctionList(reply interface{}) []FunctionLibrary {
+	items, _ := reply.([]interface{})
+	libs := make([]FunctionLibrary, 0, len(items))
+	for _, item := range items {
+		m := replyMap(item)
+		lib := FunctionLibrary{
+			Name:   replyString(m["library_name"]),
+			Engine: replyString(m["engine"]),
+			Code:   replyString(m["library_code"]),
+		}
+		functions, _ := m["functions"].([]interface{})
+		for _, f := range functions {
+			fm := replyMap(f)
+			flags := replyStrings(fm["flags"])
+			sort.Strings(flags)
+			lib.Functions = append(lib.Functions, LibraryFunction{
+				Name:        replyString(fm["name"]),
+				Description: replyString(fm["description"]),
+				Flags:       flags,
+			})
+		}
+		sort.Slice(lib.Functions, func(i, j int) bool {
+			return lib.Functions[i].Name < lib.Functions[j].Name
+		})
+		libs = append(libs, lib)
+	}
+	sort.Slice(libs, func(i, j int) bool {
+		return libs[i].Name < libs[j].Name
+	})
+	return libs
+}
+
+// FunctionLoad loads a library and returns its name; replace overwrites a library with the same name
+func (c *Client) FunctionLoad(code string, replace bool) (string, error) {
+	args := []interface{}{"FUNCTION", "LOAD"}
+	if replace {
+		args = append(args, "REPLACE")
+	}
+	name, err := c.db().Do(c.ctx, append(args, code)...).Text()
+	if err != nil {
+		return "", fmt.Errorf("failed to load library: %w", err)
+	}
+	return name, nil
+}
+
+// FunctionDelete removes a library and its functions
+func (c *Client) FunctionDelete(library string) error {
+	if err := c.db().Do(c.ctx, "FUNCTION", "DELETE", library).Err(); err != nil {
+		return fmt.Errorf("failed to delete library %s: %w", library, err)
+	}
+	return nil
+}
+
+// ScriptKill stops the running EVAL script, unless it already wrote data
+func (c *Client) ScriptKill() error {
+	if err := c.db().ScriptKill(c.ctx).Err(); err != nil {
+		return fmt.Errorf("failed to kill script: %w", err)
+	}
+	return nil
+}
+
+// FunctionKill stops the running function, unless it already wrote data
+func (c *Client) FunctionKill() error {
+	if err := c.db().FunctionKill(c.ctx).Err(); err != nil {
+		return fmt.Errorf("failed to kill function: %w", err)
+	}
+	return nil
+}
+
+// FunctionDump returns the serialized payload of every library
+func (c *Client) FunctionDump() ([]byte, error) {
+	payload, err := c.db().Do(c.ctx, "FUNCTION", "DUMP").Text()
+	if err != nil {
+		return nil, fmt.Errorf("failed to dump functions: %w", err)
+	}
+	return []byte(payload), nil
+}
+
+// FunctionRestore restores libraries from a FUNCTION DUMP payload with the APPEND, REPLACE or FLUSH policy
+func (c *Client) FunctionRestore(payload []byte, policy string) error {
+	args := []interface{}{"FUNCTION", "RESTORE", payload}
+	if policy != "" {
+		args = append(args, strings.ToUpper(policy))
+	}
+	if err := c.db().Do(c.ctx, args...).Err(); err != nil {
+		return fmt.Errorf("failed to restore functions: %w", err)
+	}
+	return nil
+}
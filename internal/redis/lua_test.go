@@ -0,0 +1,48 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseFunctionList tests reading libraries from a RESP2 FUNCTION LIST WITHCODE reply
+func TestParseFunctionList(t *testing.T) {
+	reply := []interface{}{
+		[]interface{}{
+			"library_name", "mylib",
+			"engine", "LUA",
+			"functions", []interface{}{
+				[]interface{}{"name", "myset", "description", nil, "flags", []interface{}{}},
+				[]interface{}{"name", "myget", "description", "Reads a key", "flags", []interface{}{"no-writes"}},
+			},
+			"library_code", "#!lua name=mylib\n",
+		},
+		[]interface{}{"library_name", "empty", "engine", "LUA", "functions", []interface{}{}},
+	}
+
+	libs := ParseFunctionList(reply)
+	assert.Len(t, libs, 2)
+	assert.Equal(t, "empty", libs[0].Name)
+	assert.Empty(t, libs[0].Functions)
+
+	lib := libs[1]
+	assert.Equal(t, "LUA", lib.Engine)
+	assert.Equal(t, "#!lua name=mylib\n", lib.Code)
+	assert.Equal(t, []LibraryFunction{
+		{Name: "myget", Description: "Reads a key", Flags: []string{"no-writes"}},
+		{Name: "myset", Flags: []string{}},
+	}, lib.Functions)
+}
+
+// TestScriptSHA tests the digest EVALSHA uses
+func TestScriptSHA(t *testing.T) {
+	assert.Equal(t, "e0e1f9fabfc9d4800c877a703b823ac0578ff8db", ScriptSHA("return 1"))
+}
+
+// TestDebugStoppedAt tests reading the stop line from LDB logs
+func TestDebugStoppedAt(t *testing.T) {
+	logs := []string{"* Stopped at 3, stop reason = break point", "->#3   return value"}
+	assert.Equal(t, 3, DebugStoppedAt(logs))
+	assert.Equal(t, 0, DebugStoppedAt([]string{"<value> 1"}))
+}
@@ -0,0 +1,248 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// MigrateOptions controls a key migration between two connections
+type MigrateOptions struct {
+	Pattern    string // SCAN match pattern
+	BatchSize  int    // Keys per SCAN/DUMP/RESTORE round trip
+	Replace    bool   // Overwrite keys that already exist on the target
+	RateLimit  int    // Maximum keys per second, 0 for unlimited
+	Checkpoint string // File used to resume an interrupted migration, empty to disable
+	TargetName string // Target profile name, recorded in the checkpoint
+
+	// Progress is called after every batch
+	Progress func(MigrateProgress)
+}
+
+// MigrateProgress reports how far a migration has got
+type MigrateProgress struct {
+	Source        string `json:"source"`         // Source address and DB
+	Target        string `json:"target"`         // Target address and DB
+	TargetProfile string `json:"target_profile"` // Target profile name, if any
+	Pattern       string `json:"pattern"`
+	Cursor        uint64 `json:"cursor"`
+	Scanned       int64  `json:"scanned"`
+	Migrated      int64  `json:"migrated"`
+	Skipped       int64  `json:"skipped"`
+	Failed        int64  `json:"failed"`
+	Done          bool   `json:"done"`
+}
+
+// String returns a one-line summary of the progress
+func (p MigrateProgress) String() string {
+	return fmt.Sprintf("scanned %d, migrated %d, skipped %d, failed %d",
+		p.Scanned, p.Migrated, p.Skipped, p.Failed)
+}
+
+// Migrate copies keys matching opts.Pattern from c to target using DUMP/PTTL and RESTORE
+func (c *Client) Migrate(ctx context.Context, target *Client, opts MigrateOptions) (*MigrateProgress, error) {
+	c, release := c.pin()
+	defer release()
+	target, releaseTarget := target.pin()
+	defer releaseTarget()
+	if opts.Pattern == "" {
+		opts.Pattern = "*"
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = 100
+	}
+
+	progress := &MigrateProgress{
+		Source:        c.endpoint(),
+		Target:        target.endpoint(),
+		TargetProfile: opts.TargetName,
+		Pattern:       opts.Pattern,
+	}
+	if opts.Checkpoint != "" {
+		saved, err := loadCheckpoint(opts.Checkpoint)
+		if err != nil {
+			return nil, err
+		}
+		if saved != nil && !saved.Done {
+			if !saved.sameMigration(progress) {
+				return nil, fmt.Errorf("checkpoint %s belongs to another migration (%s); delete it or choose another file",
+					opts.Checkpoint, saved.describe())
+			}
+			progress = saved
+		}
+	}
+
+	started := time.Now()
+	startMigrated := progress.Migrated
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return progress, err
+		}
+
+		keys, cursor, err := c.db().Scan(ctx, progress.Cursor, opts.Pattern, int64(opts.BatchSize)).Result()
+		if err != nil {
+			return progress, fmt.Errorf("failed to scan keys: %w", err)
+		}
+
+		// Counters only move with the cursor, so an interrupted batch is neither lost nor
+		// counted twice when it runs again
+		if len(keys) > 0 {
+			batch, err := c.migrateBatch(ctx, target, keys, opts.Replace)
+			if err != nil {
+				return progress, err
+			}
+			progress.Migrated += batch.Migrated
+			progress.Skipped += batch.Skipped
+			progress.Failed += batch.Failed
+		}
+		progress.Scanned += int64(len(keys))
+		progress.Cursor = cursor
+		progress.Done = cursor == 0
+
+		if opts.Checkpoint != "" {
+			if err := saveCheckpoint(opts.Checkpoint, progress); err != nil {
+				return progress, err
+			}
+		}
+		if opts.Progress != nil {
+			opts.Progress(*progress)
+		}
+		if progress.Done {
+			return progress, nil
+		}
+
+		if opts.RateLimit > 0 {
+			// Sleep until the average rate since start drops back to the limit
+			expected := time.Duration(float64(progress.Migrated-startMigrated) / float64(opts.RateLimit) * float64(time.Second))
+			if wait := expected - time.Since(started); wait > 0 {
+				select {
+				case <-time.After(wait):
+				case <-ctx.Done():
+					return progress, ctx.Err()
+				}
+			}
+		}
+	}
+}
+
+// migrateBatch moves one batch of keys with a pipeline on each side and returns its counts;
+// it fails without counting anything when either pipeline fails as a whole
+func (c *Client) migrateBatch(ctx context.Context, target *Client, keys []string, replace bool) (*MigrateProgress, error) {
+	dumps := make([]*redis.StringCmd, len(keys))
+	ttls := make([]*redis.DurationCmd, len(keys))
+
+	_, err := c.db().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			dumps[i] = pipe.Dump(ctx, key)
+			ttls[i] = pipe.PTTL(ctx, key)
+		}
+		return nil
+	})
+	// Per-key server errors are counted below; anything else (network, cancel) aborts
+	if _, isReply := err.(redis.Error); err != nil && !isReply {
+		return nil, fmt.Errorf("failed to dump keys: %w", err)
+	}
+
+	restores := make([]*redis.StatusCmd, len(keys))
+	_, err = target.db().Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, key := range keys {
+			payload, err := dumps[i].Result()
+			if err != nil {
+				// Key expired or was deleted between SCAN and DUMP
+				continue
+			}
+
+			ttl := ttls[i].Val()
+			if ttl < 0 {
+				ttl = 0
+			}
+
+			if replace {
+				restores[i] = pipe.RestoreReplace(ctx, key, ttl, payload)
+			} else {
+				restores[i] = pipe.Restore(ctx, key, ttl, payload)
+			}
+		}
+		return nil
+	})
+	if err != nil && ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	// BUSYKEY and other per-key replies are counted below; anything else (network) aborts
+	if _, isReply := err.(redis.Error); err != nil && !isReply {
+		return nil, fmt.Errorf("failed to restore keys: %w", err)
+	}
+
+	progress := &MigrateProgress{}
+	for i := range keys {
+		switch {
+		case restores[i] == nil:
+			progress.Skipped++
+		case restores[i].Err() == nil:
+			progress.Migrated++
+		case strings.HasPrefix(restores[i].Err().Error(), "BUSYKEY"):
+			progress.Skipped++
+		default:
+			progress.Failed++
+		}
+	}
+
+	return progress, nil
+}
+
+// sameMigration reports whether a checkpoint was saved by a migration with the same
+// source, target and pattern
+func (p *MigrateProgress) sameMigration(other *MigrateProgress) bool {
+	return p.Source == other.Source && p.Target == other.Target &&
+		p.TargetProfile == other.TargetProfile && p.Pattern == other.Pattern
+}
+
+// describe names the source, target and pattern of a migration
+func (p *MigrateProgress) describe() string {
+	target := p.Target
+	if p.TargetProfile != "" {
+		target = fmt.Sprintf("profile %s at %s", p.TargetProfile, p.Target)
+	}
+	return fmt.Sprintf("%q from %s to %s", p.Pattern, p.Source, target)
+}
+
+// loadCheckpoint reads a saved migration checkpoint, returning nil if there is none
+func loadCheckpoint(path string) (*MigrateProgress, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read checkpoint: %w", err)
+	}
+
+	var progress MigrateProgress
+	if err := json.Unmarshal(data, &progress); err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint: %w", err)
+	}
+	return &progress, nil
+}
+
+// saveCheckpoint writes the migration progress so it can be resumed later
+func saveCheckpoint(path string, progress *MigrateProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return fmt.Errorf("failed to marshal checkpoint: %w", err)
+	}
+
+	// Write to a temp file first so a crash never leaves a truncated checkpoint
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write checkpoint: %w", err)
+	}
+	return nil
+}
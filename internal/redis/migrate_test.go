@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCheckpointRoundTrip tests saving and loading migration checkpoints
+func TestCheckpointRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "migration.json")
+
+	saved, err := loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Nil(t, saved)
+
+	progress := &MigrateProgress{Source: "prod:6379/0", Target: "staging:6379/2", TargetProfile: "staging",
+		Pattern: "user:*", Cursor: 42, Scanned: 300, Migrated: 290, Skipped: 9, Failed: 1}
+	assert.NoError(t, saveCheckpoint(path, progress))
+
+	saved, err = loadCheckpoint(path)
+	assert.NoError(t, err)
+	assert.Equal(t, progress, saved)
+}
+
+// TestMigrateRefusesOtherCheckpoint tests that an unfinished checkpoint of another migration is not resumed
+func TestMigrateRefusesOtherCheckpoint(t *testing.T) {
+	source := newOfflineClient(nil, false)
+	target := newOfflineClient(nil, false)
+	path := filepath.Join(t.TempDir(), "migration.json")
+
+	base := MigrateProgress{Source: source.endpoint(), Target: target.endpoint(), TargetProfile: "staging", Pattern: "user:*", Cursor: 7}
+	others := map[string]MigrateProgress{
+		"source":  {Source: "other:6379/0", Target: base.Target, TargetProfile: base.TargetProfile, Pattern: base.Pattern},
+		"target":  {Source: base.Source, Target: "other:6379/0", TargetProfile: base.TargetProfile, Pattern: base.Pattern},
+		"profile": {Source: base.Source, Target: base.Target, TargetProfile: "qa", Pattern: base.Pattern},
+		"pattern": {Source: base.Source, Target: base.Target, TargetProfile: base.TargetProfile, Pattern: "order:*"},
+	}
+	for name, other := range others {
+		other.Cursor = 7
+		assert.NoError(t, saveCheckpoint(path, &other))
+		_, err := source.Migrate(context.Background(), target, MigrateOptions{Pattern: "user:*", Checkpoint: path, TargetName: "staging"})
+		assert.ErrorContains(t, err, "belongs to another migration", name)
+	}
+
+	// A matching checkpoint is resumed: the scan fails on the unreachable server, keeping its progress
+	assert.NoError(t, saveCheckpoint(path, &base))
+	progress, err := source.Migrate(context.Background(), target, MigrateOptions{Pattern: "user:*", Checkpoint: path, TargetName: "staging"})
+	assert.ErrorContains(t, err, "failed to scan keys")
+	assert.Equal(t, uint64(7), progress.Cursor)
+}
@@ -0,0 +1,480 @@
+package redis
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MonitorEntry is one command reported by MONITOR
+type MonitorEntry struct {
+	Time    time.Time
+	DB      int
+	Client  string // Client address, or "lua" / "unix:..." as reported by the server
+	Command string // Upper-case command name
+	Args    []string
+}
+
+// ParseMonitorLine parses a MONITOR line such as
+// 1339518083.107412 [0 127.0.0.1:60866] "set" "foo" "bar"
+func ParseMonitorLine(line string) (MonitorEntry, error) {
+	var entry MonitorEntry
+
+	space := strings.IndexByte(line, ' ')
+	if space < 0 {
+		return entry, fmt.Errorf("malformed monitor line %q", line)
+	}
+	secs, usecs, _ := strings.Cut(line[:space], ".")
+	sec, err := strconv.ParseInt(secs, 10, 64)
+	if err != nil {
+		return entry, fmt.Errorf("malformed monitor timestamp %q", line[:space])
+	}
+	usec, _ := strconv.ParseInt(usecs, 10, 64)
+	entry.Time = time.Unix(sec, usec*int64(time.Microsecond))
+
+	rest := line[space+1:]
+	if !strings.HasPrefix(rest, "[") {
+		return entry, fmt.Errorf("malformed monitor line %q", line)
+	}
+	end := strings.IndexByte(rest, ']')
+	if end < 0 {
+		return entry, fmt.Errorf("malformed monitor line %q", line)
+	}
+	db, client, _ := strings.Cut(rest[1:end], " ")
+	if entry.DB, err = strconv.Atoi(db); err != nil {
+		return entry, fmt.Errorf("malformed monitor DB %q", db)
+	}
+	entry.Client = client
+
+	args, err := parseQuotedArgs(rest[end+1:])
+	if err != nil {
+		return entry, err
+	}
+	if len(args) == 0 {
+		return entry, fmt.Errorf("monitor line without command %q", line)
+	}
+	entry.Command = strings.ToUpper(args[0])
+	entry.Args = args[1:]
+
+	return entry, nil
+}
+
+// parseQuotedArgs splits the space separated, double-quoted arguments of a MONITOR line
+func parseQuotedArgs(s string) ([]string, error) {
+	var args []string
+	for i := 0; i < len(s); {
+		if s[i] == ' ' {
+			i++
+			continue
+		}
+		if s[i] != '"' {
+			return nil, fmt.Errorf("expected quoted argument at %q", s[i:])
+		}
+
+		var arg strings.Builder
+		i++
+		for {
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated argument in %q", s)
+			}
+			c := s[i]
+			if c == '"' {
+				i++
+				break
+			}
+			if c != '\\' || i+1 >= len(s) {
+				arg.WriteByte(c)
+				i++
+				continue
+			}
+
+			switch s[i+1] {
+			case 'n':
+				arg.WriteByte('\n')
+			case 'r':
+				arg.WriteByte('\r')
+			case 't':
+				arg.WriteByte('\t')
+			case 'a':
+				arg.WriteByte('\a')
+			case 'b':
+				arg.WriteByte('\b')
+			case 'x':
+				if i+3 < len(s) {
+					if b, err := strconv.ParseUint(s[i+2:i+4], 16, 8); err == nil {
+						arg.WriteByte(byte(b))
+						i += 4
+						continue
+					}
+				}
+				arg.WriteByte('x')
+			default:
+				arg.WriteByte(s[i+1])
+			}
+			i += 2
+		}
+		args = append(args, arg.String())
+	}
+	return args, nil
+}
+
+// QuoteArg quotes an argument the way MONITOR and redis-cli print it
+func QuoteArg(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case '\\', '"':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		case '\a':
+			b.WriteString(`\a`)
+		case '\b':
+			b.WriteString(`\b`)
+		default:
+			if c < 0x20 || c >= 0x7f {
+				fmt.Fprintf(&b, `\x%02x`, c)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// QuoteArgs quotes and joins arguments with spaces
+func QuoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = QuoteArg(arg)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// MonitorFilter selects MONITOR entries; empty fields match everything
+type MonitorFilter struct {
+	Commands   []string // Upper-case command names
+	Client     string   // Substring of the client address
+	DB         int      // Negative matches any DB
+	KeyPattern string   // Glob matched against every argument
+}
+
+// Match reports whether an entry passes the filter
+func (f MonitorFilter) Match(e MonitorEntry) bool {
+	if len(f.Commands) > 0 {
+		found := false
+		for _, cmd := range f.Commands {
+			if cmd == e.Command {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if f.Client != "" && !strings.Contains(e.Client, f.Client) {
+		return false
+	}
+	if f.DB >= 0 && e.DB != f.DB {
+		return false
+	}
+	if f.KeyPattern != "" {
+		for _, arg := range e.Args {
+			if GlobMatch(f.KeyPattern, arg) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+// IsEmpty reports whether the filter matches everything
+func (f MonitorFilter) IsEmpty() bool {
+	return len(f.Commands) == 0 && f.Client == "" && f.DB < 0 && f.KeyPattern == ""
+}
+
+// String describes the active filter conditions
+func (f MonitorFilter) String() string {
+	var parts []string
+	if len(f.Commands) > 0 {
+		parts = append(parts, "cmd="+strings.Join(f.Commands, ","))
+	}
+	if f.Client != "" {
+		parts = append(parts, "client="+f.Client)
+	}
+	if f.DB >= 0 {
+		parts = append(parts, fmt.Sprintf("db=%d", f.DB))
+	}
+	if f.KeyPattern != "" {
+		parts = append(parts, "key="+f.KeyPattern)
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, " ")
+}
+
+// GlobMatch matches a string against a Redis glob pattern (* ? [abc] [^a-z] and \ escapes)
+func GlobMatch(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for len(pattern) > 1 && pattern[1] == '*' {
+				pattern = pattern[1:]
+			}
+			if len(pattern) == 1 {
+				return true
+			}
+			for i := 0; i <= len(s); i++ {
+				if GlobMatch(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if len(s) == 0 {
+				return false
+			}
+			s = s[1:]
+			pattern = pattern[1:]
+		case '[':
+			if len(s) == 0 {
+				return false
+			}
+			end := strings.IndexByte(pattern[1:], ']')
+			if end < 0 {
+				// No closing bracket: match '[' literally
+				if s[0] != '[' {
+					return false
+				}
+				s = s[1:]
+				pattern = pattern[1:]
+				continue
+			}
+			class := pattern[1 : end+1]
+			negate := strings.HasPrefix(class, "^")
+			if negate {
+				class = class[1:]
+			}
+			matched := false
+			for i := 0; i < len(class); i++ {
+				if i+2 < len(class) && class[i+1] == '-' {
+					if s[0] >= class[i] && s[0] <= class[i+2] {
+						matched = true
+					}
+					i += 2
+				} else if class[i] == s[0] {
+					matched = true
+				}
+			}
+			if matched == negate {
+				return false
+			}
+			s = s[1:]
+			pattern = pattern[end+2:]
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if len(s) == 0 || s[0] != pattern[0] {
+				return false
+			}
+			s = s[1:]
+			pattern = pattern[1:]
+		}
+	}
+	return len(s) == 0
+}
+
+// MonitorStream delivers MONITOR output read from a dedicated connection
+type MonitorStream struct {
+	entries chan MonitorEntry
+	cancel  context.CancelFunc
+
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu   sync.Mutex
+	conn *rawConn // Set once connected
+	err  error
+}
+
+// StartMonitor issues MONITOR on a dedicated connection, which is opened in the background so
+// the caller does not wait for the dial; a failed connection closes Entries with Err set
+func (c *Client) StartMonitor(ctx context.Context) *MonitorStream {
+	ctx, cancel := context.WithCancel(ctx)
+	m := &MonitorStream{
+		entries: make(chan MonitorEntry, 1024),
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go m.run(ctx, c)
+	return m
+}
+
+// run connects, starts MONITOR and parses its output
+func (m *MonitorStream) run(ctx context.Context, c *Client) {
+	defer close(m.entries)
+	defer m.cancel()
+
+	// MONITOR lines are parsed the same way on either protocol
+	conn, err := c.dialRaw(ctx, 2)
+	if err != nil {
+		m.fail(err)
+		return
+	}
+	defer conn.Close()
+
+	// Stop may have been called while dialing, before there was a connection to close
+	m.mu.Lock()
+	select {
+	case <-m.done:
+		m.mu.Unlock()
+		return
+	default:
+		m.conn = conn
+	}
+	m.mu.Unlock()
+
+	if _, err := conn.Do("MONITOR"); err != nil {
+		m.fail(fmt.Errorf("failed to start MONITOR: %w", err))
+		return
+	}
+	m.read(conn)
+}
+
+// fail records the error that ended the stream, unless it was stopped
+func (m *MonitorStream) fail(err error) {
+	select {
+	case <-m.done:
+	default:
+		m.mu.Lock()
+		m.err = err
+		m.mu.Unlock()
+	}
+}
+
+// read parses MONITOR lines until the connection is closed
+func (m *MonitorStream) read(conn *rawConn) {
+	for {
+		reply, err := conn.ReadReply()
+		if err != nil {
+			m.fail(err)
+			return
+		}
+
+		line, ok := reply.(string)
+		if !ok {
+			continue
+		}
+		entry, err := ParseMonitorLine(line)
+		if err != nil {
+			continue
+		}
+		select {
+		case m.entries <- entry:
+		case <-m.done:
+			return
+		}
+	}
+}
+
+// Entries returns the channel of parsed entries; it is closed when the stream ends
+func (m *MonitorStream) Entries() <-chan MonitorEntry {
+	return m.entries
+}
+
+// Err returns the error that ended the stream, if it was not stopped by Stop
+func (m *MonitorStream) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+// Stop ends MONITOR by closing the dedicated connection, or abandons the dial still in progress
+func (m *MonitorStream) Stop() error {
+	var err error
+	m.stopOnce.Do(func() {
+		m.mu.Lock()
+		close(m.done)
+		conn := m.conn
+		m.mu.Unlock()
+		m.cancel()
+
+		// The connection is already closed when the server ended the stream
+		if conn != nil {
+			if closeErr := conn.Close(); !errors.Is(closeErr, net.ErrClosed) {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
+
+// monitorRecord is the JSON form of a MonitorEntry
+type monitorRecord struct {
+	Time    string   `json:"time"`
+	DB      int      `json:"db"`
+	Client  string   `json:"client"`
+	Command string   `json:"command"`
+	Args    []string `json:"args"`
+}
+
+// WriteMonitorEntries writes entries as JSON lines or CSV
+func WriteMonitorEntries(w io.Writer, entries []MonitorEntry, format ExportFormat) error {
+	switch format {
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		for _, e := range entries {
+			rec := monitorRecord{
+				Time:    e.Time.Format(time.RFC3339Nano),
+				DB:      e.DB,
+				Client:  e.Client,
+				Command: e.Command,
+				Args:    e.Args,
+			}
+			if rec.Args == nil {
+				rec.Args = []string{}
+			}
+			if err := enc.Encode(rec); err != nil {
+				return err
+			}
+		}
+		return nil
+	case FormatCSV:
+		cw := csv.NewWriter(w)
+		if err := cw.Write([]string{"time", "db", "client", "command", "args"}); err != nil {
+			return err
+		}
+		for _, e := range entries {
+			row := []string{e.Time.Format(time.RFC3339Nano), strconv.Itoa(e.DB), e.Client, e.Command, QuoteArgs(e.Args)}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+		}
+		cw.Flush()
+		return cw.Error()
+	default:
+		return fmt.Errorf("unsupported monitor export format %q", format)
+	}
+}
@@ -0,0 +1,138 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseMonitorLine tests parsing MONITOR lines from clients, Lua and unix sockets
+func TestParseMonitorLine(t *testing.T) {
+	entry, err := ParseMonitorLine(`1339518083.107412 [0 127.0.0.1:60866] "set" "foo" "bar"`)
+	assert.NoError(t, err)
+	assert.Equal(t, time.Unix(1339518083, 107412000), entry.Time)
+	assert.Equal(t, 0, entry.DB)
+	assert.Equal(t, "127.0.0.1:60866", entry.Client)
+	assert.Equal(t, "SET", entry.Command)
+	assert.Equal(t, []string{"foo", "bar"}, entry.Args)
+
+	entry, err = ParseMonitorLine(`1339518083.107412 [3 lua] "get" "key with \"quotes\"\n"`)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, entry.DB)
+	assert.Equal(t, "lua", entry.Client)
+	assert.Equal(t, []string{"key with \"quotes\"\n"}, entry.Args)
+
+	entry, err = ParseMonitorLine(`1700000000.000001 [1 unix:/tmp/redis.sock] "PING"`)
+	assert.NoError(t, err)
+	assert.Equal(t, "unix:/tmp/redis.sock", entry.Client)
+	assert.Equal(t, "PING", entry.Command)
+	assert.Empty(t, entry.Args)
+
+	for _, line := range []string{
+		"OK",
+		`notatime [0 127.0.0.1:1] "get"`,
+		`1339518083.1 0 127.0.0.1:1 "get"`,
+		`1339518083.1 [0 127.0.0.1:1 "get"`,
+		`1339518083.1 [x 127.0.0.1:1] "get"`,
+		`1339518083.1 [0 127.0.0.1:1]`,
+		`1339518083.1 [0 127.0.0.1:1] "get`,
+		`1339518083.1 [0 127.0.0.1:1] get`,
+	} {
+		_, err := ParseMonitorLine(line)
+		assert.Error(t, err, line)
+	}
+}
+
+// TestParseQuotedArgs tests the escapes MONITOR uses for arguments
+func TestParseQuotedArgs(t *testing.T) {
+	tests := []struct {
+		in   string
+		args []string
+	}{
+		{` "a" "b c"`, []string{"a", "b c"}},
+		{`""`, []string{""}},
+		{`"\x00\xff\x7F"`, []string{"\x00\xff\x7f"}},
+		{`"\xZZ"`, []string{"xZZ"}},
+		{`"\n\r\t\a\b"`, []string{"\n\r\t\a\b"}},
+		{`"back\\slash" "\"q\""`, []string{`back\slash`, `"q"`}},
+		{`"\q"`, []string{"q"}},
+	}
+	for _, tt := range tests {
+		args, err := parseQuotedArgs(tt.in)
+		assert.NoError(t, err, tt.in)
+		assert.Equal(t, tt.args, args, tt.in)
+	}
+
+	// A trailing backslash escapes the closing quote
+	_, err := parseQuotedArgs(`"abc\"`)
+	assert.Error(t, err)
+	_, err = parseQuotedArgs(`abc`)
+	assert.Error(t, err)
+}
+
+// TestQuoteArgRoundTrip tests that QuoteArg output parses back to the same argument
+func TestQuoteArgRoundTrip(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c\n\x01\xff"`, QuoteArg("a\"b\\c\n\x01\xff"))
+	assert.Equal(t, `"set" "k" "hello world"`, QuoteArgs([]string{"set", "k", "hello world"}))
+
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	for _, arg := range []string{"", "plain", `\`, `"`, "trailing\\", "tab\there", "héllo", string(all)} {
+		args, err := parseQuotedArgs(QuoteArg(arg))
+		assert.NoError(t, err, arg)
+		assert.Equal(t, []string{arg}, args, arg)
+	}
+}
+
+// TestGlobMatch tests Redis glob patterns
+func TestGlobMatch(t *testing.T) {
+	tests := []struct {
+		pattern string
+		s       string
+		match   bool
+	}{
+		{"*", "", true},
+		{"user:*", "user:42", true},
+		{"user:*", "session:42", false},
+		{"*:42", "user:42", true},
+		{"a**b", "axyb", true},
+		{"h?llo", "hello", true},
+		{"h?llo", "hllo", false},
+		{"h[ae]llo", "hallo", true},
+		{"h[ae]llo", "hillo", false},
+		{"h[^e]llo", "hallo", true},
+		{"h[^e]llo", "hello", false},
+		{"[a-z]1", "q1", true},
+		{"[a-z]1", "Q1", false},
+		{"[^a-z]1", "Q1", true},
+		{"[^a-z]1", "q1", false},
+		{"[abc", "[abc", true},
+		{"[abc", "a", false},
+		{`h\*llo`, "h*llo", true},
+		{`h\*llo`, "hello", false},
+		{`\?`, "?", true},
+		{`end\`, `end\`, true},
+		{`end\`, "end", false},
+		{"key", "key", true},
+		{"key", "keys", false},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.match, GlobMatch(tt.pattern, tt.s), "%q %q", tt.pattern, tt.s)
+	}
+}
+
+// TestStartMonitorDialsInBackground tests that StartMonitor returns before connecting and
+// reports a failed connection through Err
+func TestStartMonitorDialsInBackground(t *testing.T) {
+	c := newOfflineClient(nil, false)
+
+	stream := c.StartMonitor(context.Background())
+	for range stream.Entries() {
+	}
+	assert.ErrorContains(t, stream.Err(), "failed to connect")
+	assert.NoError(t, stream.Stop())
+}
@@ -0,0 +1,292 @@
+package redis
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ChannelInfo describes an active Pub/Sub channel
+type ChannelInfo struct {
+	Name        string
+	Subscribers int64
+	Shard       bool // Sharded channel (Redis 7+ SSUBSCRIBE)
+}
+
+// PubSubOverview is a snapshot of the server's Pub/Sub state
+type PubSubOverview struct {
+	Channels      []ChannelInfo
+	PatternCount  int64
+	ShardsEnabled bool // PUBSUB SHARDCHANNELS is supported by the server
+}
+
+// GetPubSubOverview lists active channels with subscriber counts and the number of patterns
+func (c *Client) GetPubSubOverview(pattern string) (*PubSubOverview, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+	overview := &PubSubOverview{}
+
+	channels, err := c.db().PubSubChannels(c.ctx, pattern).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list channels: %w", err)
+	}
+	counts, err := c.db().PubSubNumSub(c.ctx, channels...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count subscribers: %w", err)
+	}
+	for _, name := range channels {
+		overview.Channels = append(overview.Channels, ChannelInfo{Name: name, Subscribers: counts[name]})
+	}
+
+	overview.PatternCount, err = c.db().PubSubNumPat(c.ctx).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to count patterns: %w", err)
+	}
+
+	// Sharded Pub/Sub only exists on Redis/Valkey 7+; older servers reply with an error
+	shardChannels, err := c.db().PubSubShardChannels(c.ctx, pattern).Result()
+	if err == nil {
+		overview.ShardsEnabled = true
+		shardCounts, err := c.db().PubSubShardNumSub(c.ctx, shardChannels...).Result()
+		if err != nil {
+			return nil, fmt.Errorf("failed to count shard subscribers: %w", err)
+		}
+		for _, name := range shardChannels {
+			overview.Channels = append(overview.Channels, ChannelInfo{Name: name, Subscribers: shardCounts[name], Shard: true})
+		}
+	}
+
+	sort.Slice(overview.Channels, func(i, j int) bool {
+		return overview.Channels[i].Name < overview.Channels[j].Name
+	})
+	return overview, nil
+}
+
+// Publish posts a message to a channel and returns the number of receivers
+func (c *Client) Publish(channel, message string) (int64, error) {
+	return c.db().Publish(c.ctx, channel, message).Result()
+}
+
+// SPublish posts a message to a shard channel (Redis 7+) and returns the number of receivers
+func (c *Client) SPublish(channel, message string) (int64, error) {
+	return c.db().SPublish(c.ctx, channel, message).Result()
+}
+
+// PubSubMessage is a message received by a Subscription
+type PubSubMessage struct {
+	Time    time.Time
+	Channel string
+	Pattern string
+	Payload string
+}
+
+// Subscription is a dedicated Pub/Sub connection
+type Subscription struct {
+	client   *Client
+	ps       *redis.PubSub
+	messages chan PubSubMessage
+
+	mu       sync.Mutex
+	channels map[string]bool
+	patterns map[string]bool
+	shards   map[string]bool
+}
+
+// NewSubscription opens a Pub/Sub connection with no subscriptions yet
+func (c *Client) NewSubscription() *Subscription {
+	s := &Subscription{
+		client:   c,
+		ps:       c.db().Subscribe(c.ctx),
+		messages: make(chan PubSubMessage, 256),
+		channels: make(map[string]bool),
+		patterns: make(map[string]bool),
+		shards:   make(map[string]bool),
+	}
+
+	go s.forward()
+	return s
+}
+
+// forward stamps incoming messages and passes them on until the subscription is closed
+func (s *Subscription) forward() {
+	defer close(s.messages)
+	for msg := range s.ps.Channel() {
+		s.messages <- PubSubMessage{
+			Time:    time.Now(),
+			Channel: msg.Channel,
+			Pattern: msg.Pattern,
+			Payload: msg.Payload,
+		}
+	}
+}
+
+// Messages returns the channel on which received messages are delivered
+func (s *Subscription) Messages() <-chan PubSubMessage {
+	return s.messages
+}
+
+// Subscribe subscribes to channels by their exact names
+func (s *Subscription) Subscribe(channels ...string) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.Subscribe(s.client.ctx, channels...); err != nil {
+		return fmt.Errorf("failed to subscribe: %w", err)
+	}
+	for _, ch := range channels {
+		s.channels[ch] = true
+	}
+	return nil
+}
+
+// PSubscribe subscribes to glob-style patterns
+func (s *Subscription) PSubscribe(patterns ...string) error {
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.PSubscribe(s.client.ctx, patterns...); err != nil {
+		return fmt.Errorf("failed to subscribe to pattern: %w", err)
+	}
+	for _, p := range patterns {
+		s.patterns[p] = true
+	}
+	return nil
+}
+
+// SSubscribe subscribes to shard channels (Redis 7+), which receive SPUBLISH messages
+func (s *Subscription) SSubscribe(channels ...string) error {
+	if len(channels) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.SSubscribe(s.client.ctx, channels...); err != nil {
+		return fmt.Errorf("failed to subscribe to shard channel: %w", err)
+	}
+	for _, ch := range channels {
+		s.shards[ch] = true
+	}
+	return nil
+}
+
+// Unsubscribe drops the given channel subscriptions
+func (s *Subscription) Unsubscribe(channels ...string) error {
+	// Without names UNSUBSCRIBE would drop every channel
+	if len(channels) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.Unsubscribe(s.client.ctx, channels...); err != nil {
+		return fmt.Errorf("failed to unsubscribe: %w", err)
+	}
+	for _, ch := range channels {
+		delete(s.channels, ch)
+	}
+	return nil
+}
+
+// PUnsubscribe drops the given pattern subscriptions
+func (s *Subscription) PUnsubscribe(patterns ...string) error {
+	// Without names PUNSUBSCRIBE would drop every pattern
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.PUnsubscribe(s.client.ctx, patterns...); err != nil {
+		return fmt.Errorf("failed to unsubscribe from pattern: %w", err)
+	}
+	for _, p := range patterns {
+		delete(s.patterns, p)
+	}
+	return nil
+}
+
+// SUnsubscribe drops the given shard channel subscriptions
+func (s *Subscription) SUnsubscribe(channels ...string) error {
+	// Without names SUNSUBSCRIBE would drop every shard channel
+	if len(channels) == 0 {
+		return nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.ps.SUnsubscribe(s.client.ctx, channels...); err != nil {
+		return fmt.Errorf("failed to unsubscribe from shard channel: %w", err)
+	}
+	for _, ch := range channels {
+		delete(s.shards, ch)
+	}
+	return nil
+}
+
+// UnsubscribeAll drops every channel, pattern and shard channel subscription
+func (s *Subscription) UnsubscribeAll() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.channels) > 0 {
+		if err := s.ps.Unsubscribe(s.client.ctx); err != nil {
+			return err
+		}
+		s.channels = make(map[string]bool)
+	}
+	if len(s.patterns) > 0 {
+		if err := s.ps.PUnsubscribe(s.client.ctx); err != nil {
+			return err
+		}
+		s.patterns = make(map[string]bool)
+	}
+	if len(s.shards) > 0 {
+		if err := s.ps.SUnsubscribe(s.client.ctx); err != nil {
+			return err
+		}
+		s.shards = make(map[string]bool)
+	}
+	return nil
+}
+
+// Subscriptions returns the subscribed channels, patterns and shard channels in sorted order
+func (s *Subscription) Subscriptions() (channels, patterns, shards []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.channels {
+		channels = append(channels, ch)
+	}
+	for p := range s.patterns {
+		patterns = append(patterns, p)
+	}
+	for ch := range s.shards {
+		shards = append(shards, ch)
+	}
+	sort.Strings(channels)
+	sort.Strings(patterns)
+	sort.Strings(shards)
+	return channels, patterns, shards
+}
+
+// Close closes the Pub/Sub connection
+func (s *Subscription) Close() error {
+	return s.ps.Close()
+}
@@ -0,0 +1,254 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"time"
+)
+
+// ReplyError is an error reply read from a raw connection
+type ReplyError string
+
+// Error returns the server's error message
+func (e ReplyError) Error() string {
+	return string(e)
+}
+
+// rawConn is a dedicated connection outside the pool, for commands that take over
+// the connection (MONITOR) or block for a long time and must be cancellable
+type rawConn struct {
+	conn net.Conn
+	rd   *bufio.Reader
+	wr   *bufio.Writer
+}
+
+// dialRaw opens a dedicated connection with the client's address, TLS settings, credentials
+// and DB. With protocol 3 it switches to RESP3 with HELLO, staying on RESP2 when the server
+// refuses like go-redis does.
+func (c *Client) dialRaw(ctx context.Context, protocol int) (*rawConn, error) {
+	opts := c.db().Options()
+
+	dialCtx, cancel := context.WithTimeout(ctx, opts.DialTimeout)
+	defer cancel()
+
+	conn, err := opts.Dialer(dialCtx, opts.Network, opts.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+
+	rc := &rawConn{
+		conn: conn,
+		rd:   bufio.NewReader(conn),
+		wr:   bufio.NewWriter(conn),
+	}
+
+	// The handshake must not hang on a server that accepts the connection but never replies
+	if deadline, ok := dialCtx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	authenticated := false
+	if protocol == 3 {
+		args := []interface{}{"HELLO", 3}
+		if opts.Password != "" {
+			username := opts.Username
+			if username == "" {
+				username = "default"
+			}
+			args = append(args, "AUTH", username, opts.Password)
+		}
+		_, err := rc.Do(args...)
+		var replyErr ReplyError
+		if err != nil && !errors.As(err, &replyErr) {
+			rc.Close()
+			return nil, fmt.Errorf("failed to switch to RESP3: %w", err)
+		}
+		// HELLO with AUTH also authenticates the connection
+		authenticated = err == nil
+	}
+
+	if opts.Password != "" && !authenticated {
+		args := []interface{}{"AUTH", opts.Password}
+		if opts.Username != "" {
+			args = []interface{}{"AUTH", opts.Username, opts.Password}
+		}
+		if _, err := rc.Do(args...); err != nil {
+			rc.Close()
+			return nil, fmt.Errorf("failed to authenticate: %w", err)
+		}
+	}
+	if opts.DB > 0 {
+		if _, err := rc.Do("SELECT", opts.DB); err != nil {
+			rc.Close()
+			return nil, fmt.Errorf("failed to select DB %d: %w", opts.DB, err)
+		}
+	}
+
+	return rc, nil
+}
+
+// Do sends a command and reads its reply
+func (rc *rawConn) Do(args ...interface{}) (interface{}, error) {
+	if err := rc.Send(args...); err != nil {
+		return nil, err
+	}
+	return rc.ReadReply()
+}
+
+// Send writes a command as a RESP array of bulk strings
+func (rc *rawConn) Send(args ...interface{}) error {
+	fmt.Fprintf(rc.wr, "*%d\r\n", len(args))
+	for _, arg := range args {
+		s := fmt.Sprint(arg)
+		fmt.Fprintf(rc.wr, "$%d\r\n%s\r\n", len(s), s)
+	}
+	return rc.wr.Flush()
+}
+
+// ReadReply reads one reply; error replies are returned as ReplyError
+func (rc *rawConn) ReadReply() (interface{}, error) {
+	reply, err := readReply(rc.rd)
+	if err != nil {
+		return nil, err
+	}
+	if replyErr, ok := reply.(ReplyError); ok {
+		return nil, replyErr
+	}
+	return reply, nil
+}
+
+// SetReadDeadline sets the deadline for the next reads
+func (rc *rawConn) SetReadDeadline(t time.Time) error {
+	return rc.conn.SetReadDeadline(t)
+}
+
+// Close closes the connection, unblocking any pending read
+func (rc *rawConn) Close() error {
+	return rc.conn.Close()
+}
+
+// readReply parses a RESP2 or RESP3 reply into the types go-redis uses: string, int64, nil,
+// bool, float64, *big.Int, ReplyError, []interface{} or map[interface{}]interface{}
+func readReply(rd *bufio.Reader) (interface{}, error) {
+	line, err := readLine(rd)
+	if err != nil {
+		return nil, err
+	}
+	if len(line) == 0 {
+		return nil, fmt.Errorf("empty reply line")
+	}
+
+	switch line[0] {
+	case '+':
+		return line[1:], nil
+	case '-':
+		return ReplyError(line[1:]), nil
+	case ':':
+		return strconv.ParseInt(line[1:], 10, 64)
+	case '_':
+		return nil, nil
+	case '#':
+		switch line[1:] {
+		case "t":
+			return true, nil
+		case "f":
+			return false, nil
+		}
+		return nil, fmt.Errorf("invalid boolean %q", line)
+	case ',':
+		return strconv.ParseFloat(line[1:], 64)
+	case '(':
+		n, ok := new(big.Int).SetString(line[1:], 10)
+		if !ok {
+			return nil, fmt.Errorf("invalid big number %q", line)
+		}
+		return n, nil
+	case '$', '=', '!':
+		s, isNull, err := readBulk(rd, line)
+		if err != nil || isNull {
+			return nil, err
+		}
+		switch line[0] {
+		case '=':
+			// Verbatim strings start with their format, like "txt:"
+			if len(s) >= 4 {
+				s = s[4:]
+			}
+		case '!':
+			return ReplyError(s), nil
+		}
+		return s, nil
+	case '*', '~', '>':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid array length %q", line)
+		}
+		if n < 0 {
+			return nil, nil
+		}
+		items := make([]interface{}, n)
+		for i := range items {
+			if items[i], err = readReply(rd); err != nil {
+				return nil, err
+			}
+		}
+		return items, nil
+	case '%', '|':
+		n, err := strconv.Atoi(line[1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid map length %q", line)
+		}
+		m := make(map[interface{}]interface{}, n)
+		for i := 0; i < n; i++ {
+			key, err := readReply(rd)
+			if err != nil {
+				return nil, err
+			}
+			if m[key], err = readReply(rd); err != nil {
+				return nil, err
+			}
+		}
+		// Attributes describe the reply that follows them
+		if line[0] == '|' {
+			return readReply(rd)
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("unsupported reply type %q", line[0])
+	}
+}
+
+// readBulk reads the payload of a bulk, verbatim or blob error reply whose header is line
+func readBulk(rd *bufio.Reader, line string) (string, bool, error) {
+	n, err := strconv.Atoi(line[1:])
+	if err != nil {
+		return "", false, fmt.Errorf("invalid bulk length %q", line)
+	}
+	if n < 0 {
+		return "", true, nil
+	}
+	buf := make([]byte, n+2)
+	if _, err := io.ReadFull(rd, buf); err != nil {
+		return "", false, err
+	}
+	return string(buf[:n]), false, nil
+}
+
+// readLine reads a CRLF terminated line without the terminator
+func readLine(rd *bufio.Reader) (string, error) {
+	line, err := rd.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if len(line) < 2 || line[len(line)-2] != '\r' {
+		return "", fmt.Errorf("malformed reply line %q", line)
+	}
+	return line[:len(line)-2], nil
+}
@@ -0,0 +1,114 @@
+package redis
+
+import (
+	"bufio"
+	"context"
+	"math"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestReadReplyRESP3 tests parsing RESP3 replies into the types go-redis uses
+func TestReadReplyRESP3(t *testing.T) {
+	tests := []struct {
+		raw   string
+		reply interface{}
+	}{
+		{"+OK\r\n", "OK"},
+		{":42\r\n", int64(42)},
+		{"$5\r\nhello\r\n", "hello"},
+		{"$-1\r\n", nil},
+		{"_\r\n", nil},
+		{"#t\r\n", true},
+		{"#f\r\n", false},
+		{",1.5\r\n", 1.5},
+		{"(3492890328409238509324850943850943825024385\r\n", func() *big.Int {
+			n, _ := new(big.Int).SetString("3492890328409238509324850943850943825024385", 10)
+			return n
+		}()},
+		{"=15\r\ntxt:Some string\r\n", "Some string"},
+		{"!21\r\nSYNTAX invalid syntax\r\n", ReplyError("SYNTAX invalid syntax")},
+		{"~2\r\n+a\r\n:1\r\n", []interface{}{"a", int64(1)}},
+		{">3\r\n$7\r\nmessage\r\n$4\r\nnews\r\n$2\r\nhi\r\n", []interface{}{"message", "news", "hi"}},
+		{"%2\r\n+proto\r\n:3\r\n$4\r\nmode\r\n$10\r\nstandalone\r\n", map[interface{}]interface{}{"proto": int64(3), "mode": "standalone"}},
+		{"|1\r\n+ttl\r\n:3600\r\n$3\r\nval\r\n", "val"},
+	}
+	for _, tt := range tests {
+		reply, err := readReply(bufio.NewReader(strings.NewReader(tt.raw)))
+		assert.NoError(t, err, tt.raw)
+		assert.Equal(t, tt.reply, reply, tt.raw)
+	}
+
+	reply, err := readReply(bufio.NewReader(strings.NewReader(",inf\r\n")))
+	assert.NoError(t, err)
+	assert.Equal(t, math.Inf(1), reply)
+
+	_, err = readReply(bufio.NewReader(strings.NewReader("#x\r\n")))
+	assert.Error(t, err)
+}
+
+// TestDialRawHello tests that RESP3 connections authenticate with HELLO and fall back to
+// RESP2 with AUTH when the server does not know HELLO
+func TestDialRawHello(t *testing.T) {
+	for _, hello := range []bool{true, false} {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		commands := make(chan string, 8)
+		go func() {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+			rd := bufio.NewReader(conn)
+			for {
+				req, err := readReply(rd)
+				if err != nil {
+					close(commands)
+					return
+				}
+				var args []string
+				for _, arg := range req.([]interface{}) {
+					args = append(args, arg.(string))
+				}
+				commands <- strings.Join(args, " ")
+				switch {
+				case args[0] == "HELLO" && !hello:
+					conn.Write([]byte("-ERR unknown command 'HELLO'\r\n"))
+				case args[0] == "HELLO":
+					conn.Write([]byte("%1\r\n+proto\r\n:3\r\n"))
+				default:
+					conn.Write([]byte("+OK\r\n"))
+				}
+			}
+		}()
+
+		rdb := redis.NewClient(&redis.Options{Addr: ln.Addr().String(), Password: "secret", DB: 2, DialTimeout: time.Second})
+		c := &Client{ctx: context.Background(), state: &clientState{conn: &clientConn{rdb: rdb}}}
+		rc, err := c.dialRaw(context.Background(), 3)
+		if assert.NoError(t, err) {
+			rc.Close()
+		}
+
+		var sent []string
+		for cmd := range commands {
+			sent = append(sent, cmd)
+		}
+		if hello {
+			assert.Equal(t, []string{"HELLO 3 AUTH default secret", "SELECT 2"}, sent)
+		} else {
+			assert.Equal(t, []string{"HELLO 3 AUTH default secret", "AUTH secret", "SELECT 2"}, sent)
+		}
+		rdb.Close()
+		ln.Close()
+	}
+}
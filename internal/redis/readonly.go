@@ -0,0 +1,133 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ErrReadOnly is returned for commands refused because the connection is read-only
+var ErrReadOnly = errors.New("read-only mode")
+
+// writeFlags are the COMMAND INFO flags of commands refused in read-only mode;
+// may_replicate covers EVAL, FCALL and PUBLISH, which can write without the write flag
+var writeFlags = []string{"write", "may_replicate"}
+
+// Writes reports whether COMMAND INFO flags the command as one that can change data
+func (s *CommandSpec) Writes() bool {
+	for _, flag := range writeFlags {
+		if s.HasFlag(flag) {
+			return true
+		}
+	}
+	return false
+}
+
+// ReadOnly reports whether the client refuses commands that write or administer the server
+func (c *Client) ReadOnly() bool {
+	return c.conn().readOnly
+}
+
+// readAdminCommands are admin commands that only read, which read-only mode still runs
+var readAdminCommands = map[string]bool{
+	"CLIENT LIST":       true,
+	"CONFIG GET":        true,
+	"SLOWLOG GET":       true,
+	"SLOWLOG LEN":       true,
+	"LATENCY LATEST":    true,
+	"LATENCY HISTORY":   true,
+	"LATENCY DOCTOR":    true,
+	"LATENCY GRAPH":     true,
+	"LATENCY HISTOGRAM": true,
+	"MONITOR":           true,
+	"ACL LIST":          true,
+	"ACL USERS":         true,
+	"ACL GETUSER":       true,
+	"MODULE LIST":       true,
+}
+
+// CheckWrite returns an ErrReadOnly error when the client is read-only and the command can
+// change data or the server: COMMAND INFO flags it write, may_replicate or admin (apart from
+// admin commands that only read), or the connection's guard lists it as destructive, blocking
+// or admin. Without the command table every command is refused.
+func (c *Client) CheckWrite(args []string) error {
+	cc := c.conn()
+	if !cc.readOnly || len(args) == 0 {
+		return nil
+	}
+	name := strings.ToUpper(args[0])
+	if name == "COMMAND" {
+		return nil // Loads the table the check needs
+	}
+
+	if class, matched, ok := cc.guard.lookup(args, false); ok && class != DangerSlow {
+		return fmt.Errorf("%w: %s is a %s command", ErrReadOnly, matched, class)
+	}
+
+	table, err := c.Commands()
+	if err != nil {
+		return fmt.Errorf("%w: cannot check %s without COMMAND INFO: %v", ErrReadOnly, name, err)
+	}
+	spec := table.Lookup(args)
+	switch {
+	case spec == nil:
+		return nil // Unknown commands are left for the server to reject
+	case spec.Writes():
+		return fmt.Errorf("%w: %s may write data", ErrReadOnly, spec.Name)
+	case spec.HasFlag("admin") && !readAdminCommands[spec.Name]:
+		return fmt.Errorf("%w: %s is an admin command", ErrReadOnly, spec.Name)
+	}
+	return nil
+}
+
+// readOnlyHook refuses writing commands before they reach the server, covering every
+// method of the client as well as pipelines, transactions and reserved connections
+type readOnlyHook struct {
+	client *Client
+}
+
+// DialHook leaves dialing unchanged
+func (h readOnlyHook) DialHook(next redis.DialHook) redis.DialHook {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		return next(ctx, network, addr)
+	}
+}
+
+// ProcessHook checks a single command
+func (h readOnlyHook) ProcessHook(next redis.ProcessHook) redis.ProcessHook {
+	return func(ctx context.Context, cmd redis.Cmder) error {
+		if err := h.client.CheckWrite(cmdArgs(cmd)); err != nil {
+			cmd.SetErr(err)
+			return err
+		}
+		return next(ctx, cmd)
+	}
+}
+
+// ProcessPipelineHook refuses a whole pipeline when any of its commands writes
+func (h readOnlyHook) ProcessPipelineHook(next redis.ProcessPipelineHook) redis.ProcessPipelineHook {
+	return func(ctx context.Context, cmds []redis.Cmder) error {
+		for _, cmd := range cmds {
+			if err := h.client.CheckWrite(cmdArgs(cmd)); err != nil {
+				for _, c := range cmds {
+					c.SetErr(err)
+				}
+				return err
+			}
+		}
+		return next(ctx, cmds)
+	}
+}
+
+// cmdArgs returns a command's arguments as strings
+func cmdArgs(cmd redis.Cmder) []string {
+	args := make([]string, len(cmd.Args()))
+	for i, arg := range cmd.Args() {
+		args[i] = fmt.Sprint(arg)
+	}
+	return args
+}
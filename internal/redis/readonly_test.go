@@ -0,0 +1,125 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+)
+
+// newOfflineClient returns a client whose server cannot be reached; with a nil table the
+// command table cannot be loaded either
+func newOfflineClient(table CommandTable, readOnly bool) *Client {
+	rdb := redis.NewClient(&redis.Options{Addr: "127.0.0.1:1", DialTimeout: 100 * time.Millisecond, MaxRetries: -1})
+	cc := &clientConn{
+		rdb:      rdb,
+		conns:    newConnTracker(),
+		commands: &commandCache{table: table},
+		guard:    NewGuard(config.GuardConfig{Disabled: true, Allow: []string{"CLIENT KILL"}}),
+		readOnly: readOnly,
+	}
+	c := &Client{ctx: context.Background(), state: &clientState{conn: cc}}
+	rdb.AddHook(useHook{conn: cc})
+	if readOnly {
+		rdb.AddHook(readOnlyHook{client: c})
+	}
+	return c
+}
+
+// readOnlyTestTable describes a few reading, writing and admin commands in RESP2 form
+func readOnlyTestTable() CommandTable {
+	return ParseCommandInfo([]interface{}{
+		[]interface{}{"get", int64(2), []interface{}{"readonly", "fast"}, int64(1), int64(1), int64(1)},
+		[]interface{}{"set", int64(-3), []interface{}{"write", "denyoom"}, int64(1), int64(1), int64(1)},
+		[]interface{}{"expire", int64(-3), []interface{}{"write", "fast"}, int64(1), int64(1), int64(1)},
+		[]interface{}{"eval", int64(-3), []interface{}{"noscript", "stale", "may_replicate", "movablekeys"}, int64(0), int64(0), int64(0)},
+		[]interface{}{"eval_ro", int64(-3), []interface{}{"readonly", "noscript", "stale", "movablekeys"}, int64(0), int64(0), int64(0)},
+		[]interface{}{"shutdown", int64(-1), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+		[]interface{}{"replicaof", int64(3), []interface{}{"admin", "noscript", "stale"}, int64(0), int64(0), int64(0)},
+		[]interface{}{"config", int64(-2), []interface{}{}, int64(0), int64(0), int64(0),
+			[]interface{}{}, []interface{}{}, []interface{}{},
+			[]interface{}{
+				[]interface{}{"config|get", int64(-3), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+				[]interface{}{"config|set", int64(-4), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+			}},
+		[]interface{}{"client", int64(-2), []interface{}{}, int64(0), int64(0), int64(0),
+			[]interface{}{}, []interface{}{}, []interface{}{},
+			[]interface{}{
+				[]interface{}{"client|list", int64(-2), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+				[]interface{}{"client|kill", int64(-3), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+				[]interface{}{"client|no-evict", int64(3), []interface{}{"admin", "noscript", "loading", "stale"}, int64(0), int64(0), int64(0)},
+			}},
+	})
+}
+
+// TestCheckWrite tests which commands a read-only client refuses
+func TestCheckWrite(t *testing.T) {
+	c := newOfflineClient(readOnlyTestTable(), true)
+
+	refused := [][]string{
+		{"SET", "k", "v"},
+		{"eval", "return 1", "0"},
+		{"SHUTDOWN", "NOSAVE"},
+		{"REPLICAOF", "10.0.0.1", "6379"},
+		{"SLAVEOF", "10.0.0.1", "6379"}, // Not in the table, but on the guard's admin list
+		{"CONFIG", "SET", "maxmemory", "1gb"},
+		{"CLIENT", "KILL", "ID", "7"}, // Allowed by the guard, still an admin command
+		{"CLIENT", "NO-EVICT", "on"},
+		{"DEBUG", "SLEEP", "1"},
+		{"FLUSHALL"},
+	}
+	for _, args := range refused {
+		assert.ErrorIs(t, c.CheckWrite(args), ErrReadOnly, args)
+	}
+
+	allowed := [][]string{
+		{"GET", "k"},
+		{"EVAL_RO", "return 1", "0"},
+		{"CONFIG", "GET", "*"},
+		{"CLIENT", "LIST"},
+		{"KEYS", "*"}, // O(N) is left to the guard's confirmation
+		{"NOSUCHCOMMAND"},
+		{"COMMAND", "DOCS"},
+		nil,
+	}
+	for _, args := range allowed {
+		assert.NoError(t, c.CheckWrite(args), args)
+	}
+
+	assert.NoError(t, newOfflineClient(readOnlyTestTable(), false).CheckWrite([]string{"SET", "k", "v"}))
+}
+
+// TestCheckWriteFailsClosed tests that every command is refused when the command table cannot be loaded
+func TestCheckWriteFailsClosed(t *testing.T) {
+	c := newOfflineClient(nil, true)
+	err := c.CheckWrite([]string{"GET", "k"})
+	assert.ErrorIs(t, err, ErrReadOnly)
+	assert.Contains(t, err.Error(), "without COMMAND INFO")
+}
+
+// TestReadOnlyHook tests that client methods and pipelines are refused before they reach the server
+func TestReadOnlyHook(t *testing.T) {
+	c := newOfflineClient(readOnlyTestTable(), true)
+
+	assert.ErrorIs(t, c.SetValue("k", "v"), ErrReadOnly)
+	assert.ErrorIs(t, c.SetTTL("k", time.Minute), ErrReadOnly)
+	_, err := c.ExecuteCommand("CONFIG", "SET", "maxmemory", "1gb")
+	assert.ErrorIs(t, err, ErrReadOnly)
+
+	_, err = c.db().Pipelined(c.ctx, func(pipe redis.Pipeliner) error {
+		pipe.Get(c.ctx, "k")
+		pipe.Set(c.ctx, "k", "v", 0)
+		return nil
+	})
+	assert.ErrorIs(t, err, ErrReadOnly)
+
+	// Reads pass the hook and fail on the unreachable server instead
+	_, err = c.ExecuteCommand("GET", "k")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, ErrReadOnly))
+}
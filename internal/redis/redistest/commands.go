@@ -0,0 +1,53 @@
+// Package redistest provides fixtures for tests of code that uses the redis package
+package redistest
+
+import "github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+// CommandTable builds a command table from COMMAND and COMMAND DOCS replies in RESP2 form,
+// covering GET, SET, MGET and CONFIG GET/SET
+func CommandTable() redis.CommandTable {
+	table := redis.ParseCommandInfo([]interface{}{
+		[]interface{}{"get", int64(2), []interface{}{"readonly", "fast"}, int64(1), int64(1), int64(1)},
+		[]interface{}{"set", int64(-3), []interface{}{"write", "denyoom"}, int64(1), int64(1), int64(1)},
+		[]interface{}{"mget", int64(-2), []interface{}{"readonly", "fast"}, int64(1), int64(-1), int64(1)},
+		[]interface{}{"config", int64(-2), []interface{}{}, int64(0), int64(0), int64(0),
+			[]interface{}{}, []interface{}{}, []interface{}{},
+			[]interface{}{
+				[]interface{}{"config|get", int64(-3), []interface{}{"admin"}, int64(0), int64(0), int64(0)},
+				[]interface{}{"config|set", int64(-4), []interface{}{"admin"}, int64(0), int64(0), int64(0)},
+			}},
+	})
+	redis.ParseCommandDocs(table, []interface{}{
+		"get", []interface{}{
+			"summary", "Returns the string value of a key.",
+			"arguments", []interface{}{
+				[]interface{}{"name", "key", "type", "key", "display_text", "key"},
+			},
+		},
+		"set", []interface{}{
+			"summary", "Sets the string value of a key.",
+			"arguments", []interface{}{
+				[]interface{}{"name", "key", "type", "key", "display_text", "key"},
+				[]interface{}{"name", "value", "type", "string", "display_text", "value"},
+				[]interface{}{"name", "condition", "type", "oneof", "flags", []interface{}{"optional"},
+					"arguments", []interface{}{
+						[]interface{}{"name", "nx", "type", "pure-token", "token", "NX"},
+						[]interface{}{"name", "xx", "type", "pure-token", "token", "XX"},
+					}},
+				[]interface{}{"name", "get", "type", "pure-token", "token", "GET", "flags", []interface{}{"optional"}},
+			},
+		},
+		"config", []interface{}{
+			"summary", "A container for server configuration commands.",
+			"subcommands", []interface{}{
+				"config|get", []interface{}{
+					"summary", "Returns the effective values of configuration parameters.",
+					"arguments", []interface{}{
+						[]interface{}{"name", "parameter", "type", "string", "display_text", "parameter", "flags", []interface{}{"multiple"}},
+					},
+				},
+			},
+		},
+	})
+	return table
+}
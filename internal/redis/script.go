@@ -0,0 +1,206 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/redis/go-redis/v9"
+)
+
+// ScriptMode selects how the commands of a script are sent
+type ScriptMode int
+
+// Script modes
+const (
+	ScriptSequential  ScriptMode = iota // One round trip per command
+	ScriptPipeline                      // All commands in one round trip
+	ScriptTransaction                   // All commands wrapped in MULTI/EXEC
+)
+
+// String returns the mode's name
+func (m ScriptMode) String() string {
+	switch m {
+	case ScriptPipeline:
+		return "pipeline"
+	case ScriptTransaction:
+		return "MULTI/EXEC"
+	}
+	return "sequential"
+}
+
+// ErrSkipped marks script commands not run because an earlier one failed
+var ErrSkipped = errors.New("skipped after an earlier error")
+
+// ScriptCommand is one command of a script
+type ScriptCommand struct {
+	Line int    // 1-based line number in the script
+	Text string // The line as written
+	Args []string
+}
+
+// ScriptResult is the outcome of one script command
+type ScriptResult struct {
+	Command ScriptCommand
+	Reply   interface{}
+	Err     error
+}
+
+// connectionStateCommands change the state of the connection they run on, which would leak
+// into the pooled connection a script borrows
+var connectionStateCommands = map[string]bool{
+	"MULTI":        true,
+	"EXEC":         true,
+	"DISCARD":      true,
+	"WATCH":        true,
+	"UNWATCH":      true,
+	"SELECT":       true,
+	"RESET":        true,
+	"HELLO":        true,
+	"AUTH":         true,
+	"QUIT":         true,
+	"READONLY":     true,
+	"READWRITE":    true,
+	"UNSUBSCRIBE":  true,
+	"PUNSUBSCRIBE": true,
+	"SUNSUBSCRIBE": true,
+}
+
+// checkScriptCommand returns an error for commands a script cannot run on a pooled connection:
+// connection state changes, and blocking or streaming commands that could not be stopped
+func checkScriptCommand(args []string) error {
+	name := strings.ToUpper(args[0])
+	switch {
+	case name == "MULTI" || name == "EXEC":
+		return fmt.Errorf("%s is not allowed in a script; use the MULTI/EXEC mode instead", name)
+	case connectionStateCommands[name]:
+		return fmt.Errorf("%s changes the connection's state; run it at the prompt", name)
+	case name == "CLIENT" && len(args) > 1 && strings.EqualFold(args[1], "REPLY"):
+		return fmt.Errorf("CLIENT REPLY changes the connection's state; run it at the prompt")
+	case IsStreamingCommand(args) || IsBlockingCommand(args):
+		return fmt.Errorf("%s cannot be stopped inside a script; run it at the prompt", name)
+	}
+	return nil
+}
+
+// ParseScript reads one command per line, skipping blank lines and lines starting with #.
+// Commands that change the connection's state or block it are refused.
+func ParseScript(text string) ([]ScriptCommand, error) {
+	var cmds []ScriptCommand
+	for i, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		args, err := cmdline.Split(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		if len(args) == 0 {
+			continue
+		}
+		if err := checkScriptCommand(args); err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+		cmds = append(cmds, ScriptCommand{Line: i + 1, Text: line, Args: args})
+	}
+	return cmds, nil
+}
+
+// RunScript runs the commands in the given mode and reports every result to fn in order.
+// With stopOnError, sequential runs skip the commands after the first error; pipelines
+// send everything at once, and a transaction is aborted by the server when a command
+// fails to queue.
+func (c *Client) RunScript(ctx context.Context, cmds []ScriptCommand, mode ScriptMode, stopOnError bool, fn func(ScriptResult)) error {
+	c, release := c.pin()
+	defer release()
+	switch mode {
+	case ScriptPipeline, ScriptTransaction:
+		var pipe redis.Pipeliner
+		if mode == ScriptTransaction {
+			pipe = c.db().TxPipeline()
+		} else {
+			pipe = c.db().Pipeline()
+		}
+		results := make([]*redis.Cmd, len(cmds))
+		for i, cmd := range cmds {
+			results[i] = pipe.Do(ctx, stringArgs(cmd.Args)...)
+		}
+		// Exec returns the first failed command's error; each command carries its own
+		if _, err := pipe.Exec(ctx); err != nil && ctx.Err() != nil {
+			return err
+		}
+		for i, cmd := range cmds {
+			reply, err := results[i].Result()
+			fn(ScriptResult{Command: cmd, Reply: reply, Err: nilReply(err)})
+		}
+		return nil
+	}
+
+	failed := false
+	for _, cmd := range cmds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if failed && stopOnError {
+			fn(ScriptResult{Command: cmd, Err: ErrSkipped})
+			continue
+		}
+		reply, err := c.db().Do(ctx, stringArgs(cmd.Args)...).Result()
+		err = nilReply(err)
+		if err != nil {
+			failed = true
+		}
+		fn(ScriptResult{Command: cmd, Reply: reply, Err: err})
+	}
+	return nil
+}
+
+// nilReply turns the client's nil-reply error into a plain nil reply
+func nilReply(err error) error {
+	if err == redis.Nil {
+		return nil
+	}
+	return err
+}
+
+// stringArgs converts command arguments for the client
+func stringArgs(args []string) []interface{} {
+	out := make([]interface{}, len(args))
+	for i, arg := range args {
+		out[i] = arg
+	}
+	return out
+}
+
+// Session is a pooled connection reserved for commands that keep state on the
+// connection, like WATCH and MULTI
+type Session struct {
+	conn *redis.Conn
+	ctx  context.Context
+}
+
+// NewSession reserves a connection until Close
+func (c *Client) NewSession() *Session {
+	conn := c.db().Conn()
+	if c.conn().readOnly {
+		// Reserved connections do not inherit the client's hooks
+		conn.AddHook(readOnlyHook{client: c})
+	}
+	return &Session{conn: conn, ctx: c.ctx}
+}
+
+// Do runs a command on the session's connection
+func (s *Session) Do(args ...string) (interface{}, error) {
+	cmd := redis.NewCmd(s.ctx, stringArgs(args)...)
+	_ = s.conn.Process(s.ctx, cmd)
+	reply, err := cmd.Result()
+	return reply, nilReply(err)
+}
+
+// Close returns the connection to the pool
+func (s *Session) Close() error {
+	return s.conn.Close()
+}
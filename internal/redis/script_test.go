@@ -0,0 +1,45 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestParseScript tests splitting a CLI script into commands
+func TestParseScript(t *testing.T) {
+	cmds, err := ParseScript("# setup\nSET greeting \"hello world\"\n\n  INCR counter  \n# done\n")
+	assert.NoError(t, err)
+	assert.Len(t, cmds, 2)
+	assert.Equal(t, 2, cmds[0].Line)
+	assert.Equal(t, []string{"SET", "greeting", "hello world"}, cmds[0].Args)
+	assert.Equal(t, 4, cmds[1].Line)
+	assert.Equal(t, "INCR counter", cmds[1].Text)
+
+	_, err = ParseScript("PING\nSET k \"unterminated")
+	assert.ErrorContains(t, err, "line 2")
+}
+
+// TestParseScriptRefusesConnectionCommands tests that scripts cannot change or block the pooled connection
+func TestParseScriptRefusesConnectionCommands(t *testing.T) {
+	refused := map[string]string{
+		"multi":                     "MULTI/EXEC mode",
+		"SET k v\nEXEC":             "line 2",
+		"WATCH k":                   "connection's state",
+		"SELECT 2":                  "connection's state",
+		"CLIENT REPLY OFF":          "CLIENT REPLY",
+		"SUBSCRIBE news":            "cannot be stopped",
+		"unsubscribe":               "connection's state",
+		"BLPOP queue 0":             "cannot be stopped",
+		"XREAD BLOCK 0 STREAMS s $": "cannot be stopped",
+		"# comment\n\nMONITOR":      "line 3",
+	}
+	for script, want := range refused {
+		_, err := ParseScript(script)
+		assert.ErrorContains(t, err, want, script)
+	}
+
+	cmds, err := ParseScript("CLIENT LIST\nXREAD COUNT 1 STREAMS s 0\nLPOP queue")
+	assert.NoError(t, err)
+	assert.Len(t, cmds, 3)
+}
@@ -0,0 +1,53 @@
+package redis
+
+import (
+	"fmt"
+	"time"
+)
+
+// SlowLogEntry is one entry of SLOWLOG GET
+type SlowLogEntry struct {
+	ID         int64
+	Time       time.Time
+	Duration   time.Duration
+	Args       []string // Command name followed by its arguments
+	ClientAddr string
+	ClientName string
+}
+
+// Command returns the command name of the entry
+func (e SlowLogEntry) Command() string {
+	if len(e.Args) == 0 {
+		return ""
+	}
+	return e.Args[0]
+}
+
+// GetSlowLog returns up to count slow log entries, newest first
+func (c *Client) GetSlowLog(count int64) ([]SlowLogEntry, error) {
+	logs, err := c.db().SlowLogGet(c.ctx, count).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slow log: %w", err)
+	}
+
+	entries := make([]SlowLogEntry, len(logs))
+	for i, l := range logs {
+		entries[i] = SlowLogEntry{
+			ID:         l.ID,
+			Time:       l.Time,
+			Duration:   l.Duration,
+			Args:       l.Args,
+			ClientAddr: l.ClientAddr,
+			ClientName: l.ClientName,
+		}
+	}
+	return entries, nil
+}
+
+// ResetSlowLog clears the slow log with SLOWLOG RESET
+func (c *Client) ResetSlowLog() error {
+	if err := c.db().Do(c.ctx, "SLOWLOG", "RESET").Err(); err != nil {
+		return fmt.Errorf("failed to reset slow log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// streamingCommands keep sending replies until the connection is closed
+var streamingCommands = map[string]bool{
+	"SUBSCRIBE":  true,
+	"PSUBSCRIBE": true,
+	"SSUBSCRIBE": true,
+	"MONITOR":    true,
+}
+
+// blockingCommands may wait for a long time before their single reply
+var blockingCommands = map[string]bool{
+	"BLPOP":      true,
+	"BRPOP":      true,
+	"BRPOPLPUSH": true,
+	"BLMOVE":     true,
+	"BLMPOP":     true,
+	"BZPOPMIN":   true,
+	"BZPOPMAX":   true,
+	"BZMPOP":     true,
+	"WAIT":       true,
+	"WAITAOF":    true,
+}
+
+// IsStreamingCommand reports whether a command keeps replying until it is stopped, like SUBSCRIBE and MONITOR
+func IsStreamingCommand(args []string) bool {
+	return len(args) > 0 && streamingCommands[strings.ToUpper(args[0])]
+}
+
+// IsBlockingCommand reports whether a command may block the connection, like BLPOP or XREAD BLOCK
+func IsBlockingCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	name := strings.ToUpper(args[0])
+	if name == "XREAD" || name == "XREADGROUP" {
+		for _, arg := range args[1:] {
+			if strings.EqualFold(arg, "BLOCK") {
+				return true
+			}
+		}
+		return false
+	}
+	return blockingCommands[name]
+}
+
+// CommandStream runs a blocking or streaming command on a dedicated connection so it can be stopped
+type CommandStream struct {
+	replies   chan interface{}
+	streaming bool
+	cancel    context.CancelFunc
+
+	done     chan struct{}
+	stopOnce sync.Once
+
+	mu   sync.Mutex
+	conn *rawConn // Set once connected
+	err  error
+}
+
+// StartCommand sends a command on a dedicated connection, which is opened in the background
+// so the caller does not wait for the dial; replies, including error replies as ReplyError
+// values, arrive on Replies and a failed connection ends them with Err set. The connection
+// speaks the client's protocol, so RESP3 sessions get RESP3 replies and push messages.
+func (c *Client) StartCommand(ctx context.Context, args []string) (*CommandStream, error) {
+	if len(args) == 0 {
+		return nil, fmt.Errorf("no command given")
+	}
+	if err := c.CheckWrite(args); err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s := &CommandStream{
+		replies:   make(chan interface{}, 1024),
+		streaming: IsStreamingCommand(args),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+	}
+	go s.run(ctx, c, args)
+	return s, nil
+}
+
+// run connects, sends the command and forwards its replies
+func (s *CommandStream) run(ctx context.Context, c *Client, args []string) {
+	defer close(s.replies)
+	defer s.cancel()
+
+	conn, err := c.dialRaw(ctx, c.db().Options().Protocol)
+	if err != nil {
+		s.fail(err)
+		return
+	}
+	defer conn.Close()
+
+	// Stop may have been called while dialing, before there was a connection to close
+	s.mu.Lock()
+	select {
+	case <-s.done:
+		s.mu.Unlock()
+		return
+	default:
+		s.conn = conn
+	}
+	s.mu.Unlock()
+
+	cmdArgs := make([]interface{}, len(args))
+	for i, arg := range args {
+		cmdArgs[i] = arg
+	}
+	if err := conn.Send(cmdArgs...); err != nil {
+		s.fail(fmt.Errorf("failed to send %s: %w", strings.ToUpper(args[0]), err))
+		return
+	}
+	s.read(conn)
+}
+
+// fail records the error that ended the command, unless it was stopped
+func (s *CommandStream) fail(err error) {
+	select {
+	case <-s.done:
+	default:
+		s.mu.Lock()
+		s.err = err
+		s.mu.Unlock()
+	}
+}
+
+// read forwards replies until the command finishes or the connection is closed
+func (s *CommandStream) read(conn *rawConn) {
+	for {
+		reply, err := conn.ReadReply()
+		var replyErr ReplyError
+		if errors.As(err, &replyErr) {
+			reply, err = replyErr, nil
+		}
+		if err != nil {
+			s.fail(err)
+			return
+		}
+
+		select {
+		case s.replies <- reply:
+		case <-s.done:
+			return
+		}
+
+		// A blocking command is finished after its reply, and so is a failed subscription
+		if !s.streaming {
+			return
+		}
+		if _, failed := reply.(ReplyError); failed {
+			return
+		}
+	}
+}
+
+// Replies returns the channel of replies; it is closed when the command finishes or is stopped
+func (s *CommandStream) Replies() <-chan interface{} {
+	return s.replies
+}
+
+// Err returns the error that ended the command, if it was not stopped by Stop
+func (s *CommandStream) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Stop cancels the command by closing its connection, or by abandoning the dial
+func (s *CommandStream) Stop() error {
+	var err error
+	s.stopOnce.Do(func() {
+		s.mu.Lock()
+		close(s.done)
+		conn := s.conn
+		s.mu.Unlock()
+		s.cancel()
+
+		// The connection is already closed when the command finished on its own
+		if conn != nil {
+			if closeErr := conn.Close(); !errors.Is(closeErr, net.ErrClosed) {
+				err = closeErr
+			}
+		}
+	})
+	return err
+}
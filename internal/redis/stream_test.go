@@ -0,0 +1,35 @@
+package redis
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestBlockingCommandDetection tests which CLI commands run on a dedicated connection
+func TestBlockingCommandDetection(t *testing.T) {
+	assert.True(t, IsStreamingCommand([]string{"subscribe", "news"}))
+	assert.True(t, IsStreamingCommand([]string{"MONITOR"}))
+	assert.False(t, IsStreamingCommand([]string{"PUBLISH", "news", "hi"}))
+
+	assert.True(t, IsBlockingCommand([]string{"BLPOP", "queue", "0"}))
+	assert.True(t, IsBlockingCommand([]string{"xread", "block", "0", "STREAMS", "s", "$"}))
+	assert.False(t, IsBlockingCommand([]string{"XREAD", "COUNT", "1", "STREAMS", "s", "0"}))
+	assert.False(t, IsBlockingCommand([]string{"LPOP", "queue"}))
+	assert.False(t, IsBlockingCommand(nil))
+}
+
+// TestStartCommandDialsInBackground tests that StartCommand returns before connecting and
+// reports a failed connection through Err
+func TestStartCommandDialsInBackground(t *testing.T) {
+	c := newOfflineClient(nil, false)
+
+	stream, err := c.StartCommand(context.Background(), []string{"BLPOP", "queue", "0"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	for range stream.Replies() {
+	}
+	assert.ErrorContains(t, stream.Err(), "failed to connect")
+}
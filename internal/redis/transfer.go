@@ -0,0 +1,780 @@
+package redis
+
+import (
+	"bufio"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// ExportFormat identifies an export/import file format
+type ExportFormat string
+
+const (
+	// FormatJSON is a portable JSON lines format with typed values
+	FormatJSON ExportFormat = "json"
+	// FormatCSV is a flat format for strings and hashes
+	FormatCSV ExportFormat = "csv"
+	// FormatDump is a lossless binary format of DUMP payloads with PTTL
+	FormatDump ExportFormat = "dump"
+)
+
+// ExportFormats lists the supported formats in display order
+var ExportFormats = []ExportFormat{FormatJSON, FormatCSV, FormatDump}
+
+// ConflictPolicy decides what happens when an imported key already exists
+type ConflictPolicy string
+
+const (
+	ConflictSkip    ConflictPolicy = "skip"
+	ConflictReplace ConflictPolicy = "replace"
+	ConflictRename  ConflictPolicy = "rename"
+)
+
+// ConflictPolicies lists the supported conflict policies in display order
+var ConflictPolicies = []ConflictPolicy{ConflictSkip, ConflictReplace, ConflictRename}
+
+// dumpMagic is written at the start of every dump-format file
+const dumpMagic = "RVTDUMP\x01"
+
+// Blob is a Redis string in the JSON lines export format: a JSON string when it is valid
+// UTF-8, and {"b64": "..."} otherwise so binary values survive the round trip
+type Blob string
+
+// MarshalJSON encodes the blob as a string, or as base64 when it is not valid UTF-8
+func (b Blob) MarshalJSON() ([]byte, error) {
+	if utf8.ValidString(string(b)) {
+		return json.Marshal(string(b))
+	}
+	return json.Marshal(map[string]string{"b64": base64.StdEncoding.EncodeToString([]byte(b))})
+}
+
+// UnmarshalJSON decodes either form written by MarshalJSON
+func (b *Blob) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		*b = Blob(s)
+		return nil
+	}
+
+	var encoded struct {
+		B64 *string `json:"b64"`
+	}
+	if err := json.Unmarshal(data, &encoded); err != nil || encoded.B64 == nil {
+		return fmt.Errorf("expected a string or {\"b64\": ...}, got %s", data)
+	}
+	raw, err := base64.StdEncoding.DecodeString(*encoded.B64)
+	if err != nil {
+		return fmt.Errorf("invalid base64 value: %w", err)
+	}
+	*b = Blob(raw)
+	return nil
+}
+
+// KeyRecord is a single key in the JSON lines export format
+type KeyRecord struct {
+	Key   Blob            `json:"key"`
+	Type  string          `json:"type"`
+	TTL   int64           `json:"ttl_ms"` // -1 means no expiry
+	Value json.RawMessage `json:"value"`
+}
+
+// ZMember is a sorted set member in the JSON lines export format
+type ZMember struct {
+	Member Blob    `json:"member"`
+	Score  float64 `json:"score"`
+}
+
+// FieldValue is a hash field or a stream entry field in the JSON lines export format
+type FieldValue struct {
+	Field Blob `json:"field"`
+	Value Blob `json:"value"`
+}
+
+// StreamEntry is a stream entry in the JSON lines export format; fields keep their order
+type StreamEntry struct {
+	ID     string       `json:"id"`
+	Fields []FieldValue `json:"fields"`
+}
+
+// TransferResult summarizes an export or import run
+type TransferResult struct {
+	Processed int
+	Skipped   int
+	Renamed   int
+	Errors    []error
+}
+
+// String returns a one-line summary of the result
+func (r *TransferResult) String() string {
+	s := fmt.Sprintf("%d processed, %d skipped", r.Processed, r.Skipped)
+	if r.Renamed > 0 {
+		s += fmt.Sprintf(", %d renamed", r.Renamed)
+	}
+	if len(r.Errors) > 0 {
+		s += fmt.Sprintf(", %d errors (first: %v)", len(r.Errors), r.Errors[0])
+	}
+	return s
+}
+
+// ExportKeys writes the given keys to w in the requested format
+func (c *Client) ExportKeys(w io.Writer, keys []string, format ExportFormat) (*TransferResult, error) {
+	c, release := c.pin()
+	defer release()
+	switch format {
+	case FormatJSON:
+		return c.exportJSON(w, keys)
+	case FormatCSV:
+		return c.exportCSV(w, keys)
+	case FormatDump:
+		return c.exportDump(w, keys)
+	default:
+		return nil, fmt.Errorf("unsupported export format: %s", format)
+	}
+}
+
+// ImportKeys reads keys from r in the given format and writes them to Redis
+func (c *Client) ImportKeys(r io.Reader, format ExportFormat, policy ConflictPolicy) (*TransferResult, error) {
+	c, release := c.pin()
+	defer release()
+	switch format {
+	case FormatJSON:
+		return c.importJSON(r, policy)
+	case FormatCSV:
+		return c.importCSV(r, policy)
+	case FormatDump:
+		return c.importDump(r, policy)
+	default:
+		return nil, fmt.Errorf("unsupported import format: %s", format)
+	}
+}
+
+// pttlMillis returns the remaining TTL of a key in milliseconds, or -1 if it has none
+func (c *Client) pttlMillis(key string) (int64, error) {
+	ttl, err := c.db().PTTL(c.ctx, key).Result()
+	if err != nil {
+		return -1, err
+	}
+	if ttl <= 0 {
+		return -1, nil
+	}
+	return ttl.Milliseconds(), nil
+}
+
+func (c *Client) exportJSON(w io.Writer, keys []string) (*TransferResult, error) {
+	result := &TransferResult{}
+	enc := json.NewEncoder(w)
+
+	for _, key := range keys {
+		keyType, err := c.db().Type(c.ctx, key).Result()
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		if keyType == "none" {
+			result.Skipped++
+			continue
+		}
+
+		value, err := c.readTypedValue(key, keyType)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		raw, err := json.Marshal(value)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		ttl, err := c.pttlMillis(key)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		if err := enc.Encode(KeyRecord{Key: Blob(key), Type: keyType, TTL: ttl, Value: raw}); err != nil {
+			return result, fmt.Errorf("failed to write record: %w", err)
+		}
+		result.Processed++
+	}
+
+	return result, nil
+}
+
+func (c *Client) exportCSV(w io.Writer, keys []string) (*TransferResult, error) {
+	result := &TransferResult{}
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write([]string{"key", "type", "ttl_ms", "field", "value"}); err != nil {
+		return result, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, key := range keys {
+		keyType, err := c.db().Type(c.ctx, key).Result()
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		// CSV only covers flat types; everything else needs JSON or dump
+		if keyType != "string" && keyType != "hash" {
+			result.Skipped++
+			continue
+		}
+
+		ttl, err := c.pttlMillis(key)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+		ttlStr := strconv.FormatInt(ttl, 10)
+
+		switch keyType {
+		case "string":
+			val, err := c.db().Get(c.ctx, key).Result()
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+			if err := cw.Write([]string{key, keyType, ttlStr, "", val}); err != nil {
+				return result, fmt.Errorf("failed to write record: %w", err)
+			}
+		case "hash":
+			fields, err := c.db().HGetAll(c.ctx, key).Result()
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+				continue
+			}
+			for _, fv := range hashFields(fields) {
+				if err := cw.Write([]string{key, keyType, ttlStr, string(fv.Field), string(fv.Value)}); err != nil {
+					return result, fmt.Errorf("failed to write record: %w", err)
+				}
+			}
+		}
+		result.Processed++
+	}
+
+	cw.Flush()
+	return result, cw.Error()
+}
+
+func (c *Client) exportDump(w io.Writer, keys []string) (*TransferResult, error) {
+	result := &TransferResult{}
+	bw := bufio.NewWriter(w)
+
+	if _, err := bw.WriteString(dumpMagic); err != nil {
+		return result, fmt.Errorf("failed to write header: %w", err)
+	}
+
+	for _, key := range keys {
+		payload, err := c.db().Dump(c.ctx, key).Result()
+		if err == redis.Nil {
+			result.Skipped++
+			continue
+		}
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		ttl, err := c.pttlMillis(key)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		if err := writeDumpRecord(bw, key, ttl, payload); err != nil {
+			return result, fmt.Errorf("failed to write record: %w", err)
+		}
+		result.Processed++
+	}
+
+	return result, bw.Flush()
+}
+
+// writeDumpRecord writes one length-prefixed key/ttl/payload record
+func writeDumpRecord(w io.Writer, key string, ttl int64, payload string) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	n := binary.PutUvarint(buf, uint64(len(key)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(w, key); err != nil {
+		return err
+	}
+
+	n = binary.PutVarint(buf, ttl)
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+
+	n = binary.PutUvarint(buf, uint64(len(payload)))
+	if _, err := w.Write(buf[:n]); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, payload)
+	return err
+}
+
+// readDumpRecord reads one record written by writeDumpRecord
+func readDumpRecord(r *bufio.Reader) (key string, ttl int64, payload string, err error) {
+	keyLen, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", 0, "", err
+	}
+	keyBuf := make([]byte, keyLen)
+	if _, err := io.ReadFull(r, keyBuf); err != nil {
+		return "", 0, "", unexpectedEOF(err)
+	}
+
+	ttl, err = binary.ReadVarint(r)
+	if err != nil {
+		return "", 0, "", unexpectedEOF(err)
+	}
+
+	payloadLen, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", 0, "", unexpectedEOF(err)
+	}
+	payloadBuf := make([]byte, payloadLen)
+	if _, err := io.ReadFull(r, payloadBuf); err != nil {
+		return "", 0, "", unexpectedEOF(err)
+	}
+
+	return string(keyBuf), ttl, string(payloadBuf), nil
+}
+
+// unexpectedEOF turns a clean EOF in the middle of a record into an error
+func unexpectedEOF(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (c *Client) importJSON(r io.Reader, policy ConflictPolicy) (*TransferResult, error) {
+	result := &TransferResult{}
+	dec := json.NewDecoder(r)
+
+	for {
+		var rec KeyRecord
+		if err := dec.Decode(&rec); err == io.EOF {
+			break
+		} else if err != nil {
+			return result, fmt.Errorf("failed to parse record: %w", err)
+		}
+
+		key := string(rec.Key)
+		value, err := decodeTypedValue(rec.Type, rec.Value)
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+			continue
+		}
+
+		err = c.importKey(key, policy, result, func(target string, replace bool) error {
+			return c.writeTypedValue(target, rec.Type, value, rec.TTL, replace)
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	return result, nil
+}
+
+// csvKey is one key gathered from the rows of a CSV export
+type csvKey struct {
+	key     string
+	keyType string
+	ttl     int64
+	value   string
+	fields  []FieldValue
+}
+
+// groupCSVRows gathers the rows of a CSV export per key, in file order, so hashes are
+// written in one go; a leading header row is skipped
+func groupCSVRows(rows [][]string) ([]*csvKey, []error) {
+	var keys []*csvKey
+	var errs []error
+	grouped := make(map[string]*csvKey)
+
+	for i, row := range rows {
+		if i == 0 && len(row) > 0 && row[0] == "key" {
+			continue
+		}
+		line := i + 1
+		if len(row) != 5 {
+			errs = append(errs, fmt.Errorf("line %d: expected 5 columns, got %d", line, len(row)))
+			continue
+		}
+		ttl, err := strconv.ParseInt(row[2], 10, 64)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: invalid ttl %q", line, row[2]))
+			continue
+		}
+
+		k, ok := grouped[row[0]]
+		if !ok {
+			k = &csvKey{key: row[0], keyType: row[1], ttl: ttl}
+			grouped[row[0]] = k
+			keys = append(keys, k)
+		}
+		if k.keyType == "hash" {
+			k.fields = append(k.fields, FieldValue{Field: Blob(row[3]), Value: Blob(row[4])})
+		} else {
+			k.value = row[4]
+		}
+	}
+	return keys, errs
+}
+
+func (c *Client) importCSV(r io.Reader, policy ConflictPolicy) (*TransferResult, error) {
+	result := &TransferResult{}
+	cr := csv.NewReader(r)
+
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return result, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+
+	keys, errs := groupCSVRows(rows)
+	result.Errors = append(result.Errors, errs...)
+
+	for _, k := range keys {
+		var value interface{}
+		switch k.keyType {
+		case "string":
+			value = Blob(k.value)
+		case "hash":
+			value = k.fields
+		default:
+			result.Errors = append(result.Errors, fmt.Errorf("%s: unsupported CSV type %q", k.key, k.keyType))
+			continue
+		}
+
+		err := c.importKey(k.key, policy, result, func(target string, replace bool) error {
+			return c.writeTypedValue(target, k.keyType, value, k.ttl, replace)
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", k.key, err))
+		}
+	}
+
+	return result, nil
+}
+
+func (c *Client) importDump(r io.Reader, policy ConflictPolicy) (*TransferResult, error) {
+	result := &TransferResult{}
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(dumpMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || string(magic) != dumpMagic {
+		return result, fmt.Errorf("not a dump export file")
+	}
+
+	for {
+		key, ttl, payload, err := readDumpRecord(br)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return result, fmt.Errorf("failed to read record: %w", err)
+		}
+
+		restoreTTL := time.Duration(0)
+		if ttl > 0 {
+			restoreTTL = time.Duration(ttl) * time.Millisecond
+		}
+
+		err = c.importKey(key, policy, result, func(target string, replace bool) error {
+			var err error
+			if replace {
+				err = c.db().RestoreReplace(c.ctx, target, restoreTTL, payload).Err()
+			} else {
+				err = c.db().Restore(c.ctx, target, restoreTTL, payload).Err()
+			}
+			if err != nil && strings.HasPrefix(err.Error(), "BUSYKEY") {
+				return errKeyExists
+			}
+			return err
+		})
+		if err != nil {
+			result.Errors = append(result.Errors, fmt.Errorf("%s: %w", key, err))
+		}
+	}
+
+	return result, nil
+}
+
+// errKeyExists is returned by import writes that found their target key already present
+var errKeyExists = errors.New("key exists")
+
+// importKey writes one imported key according to the conflict policy. write must fail with
+// errKeyExists, without writing, when replace is false and the target exists, so the check
+// and the write cannot race with other clients.
+func (c *Client) importKey(key string, policy ConflictPolicy, result *TransferResult, write func(target string, replace bool) error) error {
+	err := write(key, policy == ConflictReplace)
+	if !errors.Is(err, errKeyExists) {
+		if err == nil {
+			result.Processed++
+		}
+		return err
+	}
+	if policy != ConflictRename {
+		result.Skipped++
+		return nil
+	}
+
+	// Try <key>:imported, <key>:imported:2, ... until one is free
+	base := key + ":imported"
+	target := base
+	for i := 2; ; i++ {
+		err := write(target, false)
+		if !errors.Is(err, errKeyExists) {
+			if err == nil {
+				result.Renamed++
+				result.Processed++
+			}
+			return err
+		}
+		target = fmt.Sprintf("%s:%d", base, i)
+	}
+}
+
+// ErrNoSuchKey is returned when reading a key that does not exist
+var ErrNoSuchKey = errors.New("key does not exist")
+
+// ReadValue returns a key's type and its value in the JSON-friendly form of the export format
+func (c *Client) ReadValue(key string) (string, interface{}, error) {
+	keyType, err := c.db().Type(c.ctx, key).Result()
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get key type: %w", err)
+	}
+	if keyType == "none" {
+		return "", nil, ErrNoSuchKey
+	}
+
+	value, err := c.readTypedValue(key, keyType)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to read %s: %w", key, err)
+	}
+	return keyType, value, nil
+}
+
+// readTypedValue reads a key into a JSON-friendly value for its type
+func (c *Client) readTypedValue(key, keyType string) (interface{}, error) {
+	switch keyType {
+	case "string":
+		value, err := c.db().Get(c.ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		return Blob(value), nil
+	case "list":
+		values, err := c.db().LRange(c.ctx, key, 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+		return blobs(values), nil
+	case "set":
+		values, err := c.db().SMembers(c.ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		return blobs(values), nil
+	case "hash":
+		fields, err := c.db().HGetAll(c.ctx, key).Result()
+		if err != nil {
+			return nil, err
+		}
+		return hashFields(fields), nil
+	case "zset":
+		values, err := c.db().ZRangeWithScores(c.ctx, key, 0, -1).Result()
+		if err != nil {
+			return nil, err
+		}
+		members := make([]ZMember, 0, len(values))
+		for _, z := range values {
+			members = append(members, ZMember{Member: Blob(fmt.Sprint(z.Member)), Score: z.Score})
+		}
+		return members, nil
+	case "stream":
+		// The raw reply keeps each entry's fields in order, unlike XRange's maps
+		reply, err := c.db().Do(c.ctx, "XRANGE", key, "-", "+").Result()
+		if err != nil {
+			return nil, err
+		}
+		return parseStreamEntries(reply)
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", keyType)
+	}
+}
+
+// blobs converts strings read from Redis to blobs
+func blobs(values []string) []Blob {
+	out := make([]Blob, len(values))
+	for i, v := range values {
+		out[i] = Blob(v)
+	}
+	return out
+}
+
+// hashFields returns a hash's fields sorted by name
+func hashFields(fields map[string]string) []FieldValue {
+	out := make([]FieldValue, 0, len(fields))
+	for f, v := range fields {
+		out = append(out, FieldValue{Field: Blob(f), Value: Blob(v)})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Field < out[j].Field })
+	return out
+}
+
+// parseStreamEntries parses an XRANGE reply: entries of an ID and a flat field/value list
+func parseStreamEntries(reply interface{}) ([]StreamEntry, error) {
+	items, ok := reply.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("unexpected XRANGE reply: %T", reply)
+	}
+	entries := make([]StreamEntry, 0, len(items))
+	for _, item := range items {
+		entry, ok := item.([]interface{})
+		if !ok || len(entry) != 2 {
+			return nil, fmt.Errorf("unexpected XRANGE entry: %v", item)
+		}
+		id, _ := entry[0].(string)
+		values, _ := entry[1].([]interface{})
+		if len(values)%2 != 0 {
+			return nil, fmt.Errorf("odd field list in stream entry %s", id)
+		}
+		fields := make([]FieldValue, 0, len(values)/2)
+		for i := 0; i < len(values); i += 2 {
+			fields = append(fields, FieldValue{Field: Blob(fmt.Sprint(values[i])), Value: Blob(fmt.Sprint(values[i+1]))})
+		}
+		entries = append(entries, StreamEntry{ID: id, Fields: fields})
+	}
+	return entries, nil
+}
+
+// decodeTypedValue parses the JSON value of an export record into the form readTypedValue returns
+func decodeTypedValue(keyType string, raw json.RawMessage) (interface{}, error) {
+	var target interface{}
+	switch keyType {
+	case "string":
+		target = new(Blob)
+	case "list", "set":
+		target = new([]Blob)
+	case "hash":
+		target = new([]FieldValue)
+	case "zset":
+		target = new([]ZMember)
+	case "stream":
+		target = new([]StreamEntry)
+	default:
+		return nil, fmt.Errorf("unsupported key type: %s", keyType)
+	}
+	if err := json.Unmarshal(raw, target); err != nil {
+		return nil, fmt.Errorf("invalid %s value: %w", keyType, err)
+	}
+	return reflect.ValueOf(target).Elem().Interface(), nil
+}
+
+// writeTypedValue writes a value of the given type, in the form decodeTypedValue returns,
+// and applies the TTL atomically.
+// Unless replace is set, the key is watched and the write fails with errKeyExists when the
+// key exists or appears before the transaction runs.
+func (c *Client) writeTypedValue(key, keyType string, value interface{}, ttl int64, replace bool) error {
+	write := func(pipe redis.Pipeliner) error {
+		pipe.Del(c.ctx, key)
+
+		switch v := value.(type) {
+		case Blob:
+			pipe.Set(c.ctx, key, string(v), 0)
+		case []Blob:
+			if len(v) > 0 && keyType == "set" {
+				pipe.SAdd(c.ctx, key, blobArgs(v)...)
+			} else if len(v) > 0 {
+				pipe.RPush(c.ctx, key, blobArgs(v)...)
+			}
+		case []FieldValue:
+			if len(v) > 0 {
+				pipe.HSet(c.ctx, key, fieldArgs(v)...)
+			}
+		case []ZMember:
+			members := make([]redis.Z, 0, len(v))
+			for _, m := range v {
+				members = append(members, redis.Z{Member: string(m.Member), Score: m.Score})
+			}
+			if len(members) > 0 {
+				pipe.ZAdd(c.ctx, key, members...)
+			}
+		case []StreamEntry:
+			for _, e := range v {
+				pipe.XAdd(c.ctx, &redis.XAddArgs{Stream: key, ID: e.ID, Values: fieldArgs(e.Fields)})
+			}
+		default:
+			return fmt.Errorf("unsupported value: %T", value)
+		}
+
+		if ttl > 0 {
+			pipe.PExpire(c.ctx, key, time.Duration(ttl)*time.Millisecond)
+		}
+		return nil
+	}
+
+	var err error
+	if replace {
+		_, err = c.db().TxPipelined(c.ctx, write)
+	} else {
+		err = c.db().Watch(c.ctx, func(tx *redis.Tx) error {
+			exists, err := tx.Exists(c.ctx, key).Result()
+			if err != nil {
+				return err
+			}
+			if exists > 0 {
+				return errKeyExists
+			}
+			_, err = tx.TxPipelined(c.ctx, write)
+			return err
+		}, key)
+		if errors.Is(err, redis.TxFailedErr) {
+			return errKeyExists // Another client wrote the key in the meantime
+		}
+	}
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+	return err
+}
+
+// blobArgs converts blobs to the variadic form go-redis expects
+func blobArgs(values []Blob) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, v := range values {
+		args[i] = string(v)
+	}
+	return args
+}
+
+// fieldArgs flattens fields to alternating field and value arguments, keeping their order
+func fieldArgs(fields []FieldValue) []interface{} {
+	args := make([]interface{}, 0, 2*len(fields))
+	for _, fv := range fields {
+		args = append(args, string(fv.Field), string(fv.Value))
+	}
+	return args
+}
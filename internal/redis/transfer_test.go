@@ -0,0 +1,197 @@
+package redis
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDumpRecordRoundTrip tests writing and reading RVTDUMP records
+func TestDumpRecordRoundTrip(t *testing.T) {
+	records := []struct {
+		key     string
+		ttl     int64
+		payload string
+	}{
+		{"user:1", -1, "\x00\x05hello\x0b\x00"},
+		{"", 1500, ""},
+		{"bin:\xff\xfe", 86400000, string(bytes.Repeat([]byte{0xff}, 300))},
+	}
+
+	var buf bytes.Buffer
+	for _, r := range records {
+		assert.NoError(t, writeDumpRecord(&buf, r.key, r.ttl, r.payload))
+	}
+
+	br := bufio.NewReader(&buf)
+	for _, want := range records {
+		key, ttl, payload, err := readDumpRecord(br)
+		assert.NoError(t, err)
+		assert.Equal(t, want.key, key)
+		assert.Equal(t, want.ttl, ttl)
+		assert.Equal(t, want.payload, payload)
+	}
+	_, _, _, err := readDumpRecord(br)
+	assert.Equal(t, io.EOF, err)
+}
+
+// TestReadDumpRecordTruncated tests that a record cut short is an error rather than a clean end
+func TestReadDumpRecordTruncated(t *testing.T) {
+	var buf bytes.Buffer
+	assert.NoError(t, writeDumpRecord(&buf, "key", 100, "payload"))
+	full := buf.Bytes()
+
+	for _, n := range []int{1, 4, 5, len(full) - 1} {
+		_, _, _, err := readDumpRecord(bufio.NewReader(bytes.NewReader(full[:n])))
+		assert.ErrorIs(t, err, io.ErrUnexpectedEOF, "cut at %d", n)
+	}
+}
+
+// TestImportDumpRejectsOtherFiles tests the RVTDUMP magic check
+func TestImportDumpRejectsOtherFiles(t *testing.T) {
+	c := newOfflineClient(nil, false)
+	_, err := c.ImportKeys(bytes.NewReader([]byte(`{"key":"k"}`)), FormatDump, ConflictSkip)
+	assert.ErrorContains(t, err, "not a dump export file")
+
+	result, err := c.ImportKeys(bytes.NewReader([]byte(dumpMagic)), FormatDump, ConflictSkip)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, result.Processed)
+}
+
+// TestBlobJSON tests that blobs encode text as strings and binary data as base64
+func TestBlobJSON(t *testing.T) {
+	tests := []struct {
+		blob Blob
+		json string
+	}{
+		{"hello", `"hello"`},
+		{"", `""`},
+		{"héllo ☃", `"héllo ☃"`},
+		{"\xff\x00\x01", `{"b64":"/wAB"}`},
+	}
+	for _, tt := range tests {
+		data, err := json.Marshal(tt.blob)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.json, string(data))
+
+		var decoded Blob
+		assert.NoError(t, json.Unmarshal(data, &decoded))
+		assert.Equal(t, tt.blob, decoded)
+	}
+
+	var b Blob
+	assert.Error(t, json.Unmarshal([]byte(`{"b64":"not base64!"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`{"hex":"ff"}`), &b))
+	assert.Error(t, json.Unmarshal([]byte(`42`), &b))
+}
+
+// TestTypedValueJSONRoundTrip tests that every type survives an export record and decodeTypedValue
+func TestTypedValueJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		keyType string
+		value   interface{}
+	}{
+		{"string", Blob("\x89PNG\r\n\x1a\n")},
+		{"list", []Blob{"a", "\xff", "a"}},
+		{"set", []Blob{"x", "\xc3\x28"}},
+		{"hash", []FieldValue{{Field: "name", Value: "ada"}, {Field: "\xfe", Value: "\x00"}}},
+		{"zset", []ZMember{{Member: "low", Score: -1.5}, {Member: "\xff", Score: 3}}},
+		{"stream", []StreamEntry{
+			{ID: "1-0", Fields: []FieldValue{{Field: "z", Value: "1"}, {Field: "a", Value: "\xff"}}},
+			{ID: "2-0", Fields: []FieldValue{{Field: "a", Value: "2"}}},
+		}},
+	}
+	for _, tt := range tests {
+		raw, err := json.Marshal(tt.value)
+		assert.NoError(t, err)
+
+		var line bytes.Buffer
+		assert.NoError(t, json.NewEncoder(&line).Encode(KeyRecord{Key: "k:\xff", Type: tt.keyType, TTL: -1, Value: raw}))
+		assert.NotContains(t, line.String(), "\ufffd", tt.keyType)
+
+		var rec KeyRecord
+		assert.NoError(t, json.NewDecoder(&line).Decode(&rec))
+		assert.Equal(t, Blob("k:\xff"), rec.Key)
+
+		value, err := decodeTypedValue(rec.Type, rec.Value)
+		assert.NoError(t, err, tt.keyType)
+		assert.Equal(t, tt.value, value, tt.keyType)
+	}
+
+	_, err := decodeTypedValue("vectorset", json.RawMessage(`[]`))
+	assert.ErrorContains(t, err, "unsupported key type")
+	_, err = decodeTypedValue("hash", json.RawMessage(`{"f":"v"}`))
+	assert.ErrorContains(t, err, "invalid hash value")
+}
+
+// TestParseStreamEntries tests that stream fields keep their order
+func TestParseStreamEntries(t *testing.T) {
+	entries, err := parseStreamEntries([]interface{}{
+		[]interface{}{"1-0", []interface{}{"z", "1", "a", "2"}},
+		[]interface{}{"1-1", []interface{}{}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []StreamEntry{
+		{ID: "1-0", Fields: []FieldValue{{Field: "z", Value: "1"}, {Field: "a", Value: "2"}}},
+		{ID: "1-1", Fields: []FieldValue{}},
+	}, entries)
+
+	_, err = parseStreamEntries([]interface{}{[]interface{}{"1-0", []interface{}{"odd"}}})
+	assert.Error(t, err)
+	_, err = parseStreamEntries("OK")
+	assert.Error(t, err)
+}
+
+// TestHashFields tests that hash fields are sorted by name
+func TestHashFields(t *testing.T) {
+	assert.Equal(t, []FieldValue{{Field: "a", Value: "1"}, {Field: "b", Value: "2"}, {Field: "c", Value: "3"}},
+		hashFields(map[string]string{"c": "3", "a": "1", "b": "2"}))
+	assert.Empty(t, hashFields(nil))
+}
+
+// TestGroupCSVRows tests that CSV rows are grouped per key in file order
+func TestGroupCSVRows(t *testing.T) {
+	rows := [][]string{
+		{"key", "type", "ttl_ms", "field", "value"},
+		{"user:1", "hash", "-1", "name", "ada"},
+		{"greeting", "string", "5000", "", "hello"},
+		{"user:1", "hash", "-1", "lang", "en"},
+		{"broken", "string"},
+		{"bad-ttl", "string", "soon", "", "x"},
+		{"user:2", "hash", "60000", "name", "alan"},
+	}
+
+	keys, errs := groupCSVRows(rows)
+	if !assert.Len(t, keys, 3) {
+		return
+	}
+
+	assert.Equal(t, "user:1", keys[0].key)
+	assert.Equal(t, "hash", keys[0].keyType)
+	assert.Equal(t, int64(-1), keys[0].ttl)
+	assert.Equal(t, []FieldValue{{Field: "name", Value: "ada"}, {Field: "lang", Value: "en"}}, keys[0].fields)
+
+	assert.Equal(t, "greeting", keys[1].key)
+	assert.Equal(t, "hello", keys[1].value)
+	assert.Equal(t, int64(5000), keys[1].ttl)
+
+	assert.Equal(t, "user:2", keys[2].key)
+
+	if !assert.Len(t, errs, 2) {
+		return
+	}
+	assert.ErrorContains(t, errs[0], "line 5: expected 5 columns")
+	assert.ErrorContains(t, errs[1], `line 6: invalid ttl "soon"`)
+
+	// Files without a header row are read from the first line
+	keys, errs = groupCSVRows([][]string{{"k", "string", "-1", "", "v"}})
+	assert.Empty(t, errs)
+	if !assert.Len(t, keys, 1) {
+		return
+	}
+	assert.Equal(t, "v", keys[0].value)
+}
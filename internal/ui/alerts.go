@@ -0,0 +1,395 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/utils"
+
+	"github.com/dustin/go-humanize"
+)
+
+const (
+	// alertHistorySize is how many alert events are kept
+	alertHistorySize = 500
+
+	// alertHookTimeout bounds how long the alert hook may run
+	alertHookTimeout = 10 * time.Second
+)
+
+// alertOperators are the comparison operators accepted in rules
+var alertOperators = []string{">=", "<=", "!=", "==", ">", "<"}
+
+// alertTrends are the operators that compare a field with its previous sample
+var alertTrends = []string{"increasing", "decreasing", "changed"}
+
+// alertRule is a parsed rule expression
+type alertRule struct {
+	name  string
+	expr  string
+	field string
+	op    string
+
+	// Right-hand side: a number, a percentage of another field, another field or a literal
+	number  float64
+	percent bool
+	ref     string
+	literal string
+	numeric bool
+}
+
+// parseAlertRule parses "field op value", "field op N% other_field" or "field increasing"
+func parseAlertRule(name, expr string) (*alertRule, error) {
+	tokens := strings.Fields(expr)
+	if name == "" {
+		name = expr
+	}
+	rule := &alertRule{name: name, expr: expr}
+
+	if len(tokens) == 2 {
+		for _, trend := range alertTrends {
+			if strings.EqualFold(tokens[1], trend) {
+				rule.field, rule.op = tokens[0], trend
+				return rule, nil
+			}
+		}
+	}
+
+	// Operators may be written without spaces, as in "mem_fragmentation_ratio>1.5"
+	if len(tokens) == 1 {
+		for _, op := range alertOperators {
+			if i := strings.Index(expr, op); i > 0 {
+				tokens = []string{expr[:i], op, expr[i+len(op):]}
+				break
+			}
+		}
+	}
+
+	if len(tokens) < 3 || len(tokens) > 4 {
+		return nil, fmt.Errorf("invalid alert rule %q: expected \"field op value\" or \"field increasing\"", expr)
+	}
+
+	rule.field, rule.op = tokens[0], tokens[1]
+	if !contains(alertOperators, rule.op) {
+		return nil, fmt.Errorf("invalid alert rule %q: unknown operator %q", expr, rule.op)
+	}
+
+	value := tokens[2]
+	switch {
+	case strings.HasSuffix(value, "%"):
+		// "90% maxmemory" compares with a share of another field
+		if len(tokens) != 4 {
+			return nil, fmt.Errorf("invalid alert rule %q: a percentage needs a field, as in \"90%% maxmemory\"", expr)
+		}
+		n, err := strconv.ParseFloat(strings.TrimSuffix(value, "%"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alert rule %q: bad percentage %q", expr, value)
+		}
+		rule.number, rule.percent, rule.ref, rule.numeric = n, true, tokens[3], true
+	case len(tokens) == 4:
+		return nil, fmt.Errorf("invalid alert rule %q: unexpected %q", expr, tokens[3])
+	default:
+		if n, ok := parseAlertNumber(value); ok {
+			rule.number, rule.numeric = n, true
+		} else if unquoted, err := strconv.Unquote(value); err == nil {
+			rule.literal = unquoted
+		} else {
+			// A bare word is another field if the server reports it, otherwise a literal
+			rule.ref, rule.literal = value, value
+		}
+	}
+
+	if !rule.numeric && rule.op != "==" && rule.op != "!=" {
+		if rule.ref == "" {
+			return nil, fmt.Errorf("invalid alert rule %q: %s needs a number", expr, rule.op)
+		}
+	}
+
+	return rule, nil
+}
+
+// parseAlertNumber parses a plain number or a byte size such as 512mb
+func parseAlertNumber(s string) (float64, bool) {
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		return n, true
+	}
+	if s != "" && s[0] >= '0' && s[0] <= '9' {
+		if n, err := humanize.ParseBytes(s); err == nil {
+			return float64(n), true
+		}
+	}
+	return 0, false
+}
+
+// evaluate checks the rule against an INFO sample; ok is false when the rule does not apply
+func (r *alertRule) evaluate(info, prev map[string]interface{}) (firing bool, value string, ok bool) {
+	cur, found := info[r.field]
+	if !found {
+		return false, "", false
+	}
+	value = fmt.Sprint(cur)
+
+	if contains(alertTrends, r.op) {
+		if prev == nil {
+			return false, value, false
+		}
+		old, found := prev[r.field]
+		if !found {
+			return false, value, false
+		}
+		if r.op == "changed" {
+			return fmt.Sprint(old) != value, fmt.Sprintf("%v → %s", old, value), true
+		}
+		a, okA := infoNumber(old)
+		b, okB := infoNumber(cur)
+		if !okA || !okB {
+			return false, value, false
+		}
+		if r.op == "increasing" {
+			return b > a, fmt.Sprintf("%v → %s", old, value), true
+		}
+		return b < a, fmt.Sprintf("%v → %s", old, value), true
+	}
+
+	// Resolve the right-hand side
+	var target float64
+	numeric := r.numeric
+	literal := r.literal
+	switch {
+	case r.percent:
+		ref, found := infoNumber(info[r.ref])
+		if !found || ref == 0 {
+			// e.g. maxmemory 0 means no limit, so there is nothing to compare with
+			return false, value, false
+		}
+		target = ref * r.number / 100
+	case r.numeric:
+		target = r.number
+	case r.ref != "":
+		if other, found := info[r.ref]; found {
+			literal = fmt.Sprint(other)
+			target, numeric = infoNumber(other)
+		} else if r.op != "==" && r.op != "!=" {
+			return false, value, false
+		}
+	}
+
+	if numeric {
+		n, isNumber := infoNumber(cur)
+		if !isNumber {
+			return false, value, false
+		}
+		return compareNumbers(n, r.op, target), value, true
+	}
+
+	switch r.op {
+	case "==":
+		return value == literal, value, true
+	case "!=":
+		return value != literal, value, true
+	}
+	return false, value, false
+}
+
+// compareNumbers applies a comparison operator
+func compareNumbers(a float64, op string, b float64) bool {
+	switch op {
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case "==":
+		return a == b
+	case "!=":
+		return a != b
+	}
+	return false
+}
+
+// infoNumber converts an INFO value to a number
+func infoNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// contains reports whether list holds s
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// alertEvent is a rule starting or stopping to fire
+type alertEvent struct {
+	Time     time.Time
+	Rule     string
+	Expr     string
+	Value    string
+	Resolved bool
+}
+
+// alertStatus is the current state of one rule
+type alertStatus struct {
+	Name   string
+	Expr   string
+	Err    error // Set when the rule could not be parsed
+	Firing bool
+	Since  time.Time
+	Value  string
+}
+
+// alertManager evaluates the configured rules and keeps the alert history
+type alertManager struct {
+	mu      sync.Mutex
+	rules   []*alertRule
+	status  []alertStatus
+	prev    map[string]interface{}
+	history *utils.Ring[alertEvent]
+	hook    string
+}
+
+// newAlertManager parses the configured rules; rules that do not parse are reported in Status
+func newAlertManager(cfg config.AlertsConfig) *alertManager {
+	m := &alertManager{
+		history: utils.NewRing[alertEvent](alertHistorySize),
+		hook:    cfg.Hook,
+	}
+
+	for _, r := range cfg.Rules {
+		rule, err := parseAlertRule(r.Name, r.Expr)
+		if err != nil {
+			logger.Errorf("[Alerts] %v", err)
+			name := r.Name
+			if name == "" {
+				name = r.Expr
+			}
+			m.status = append(m.status, alertStatus{Name: name, Expr: r.Expr, Err: err})
+			m.rules = append(m.rules, nil)
+			continue
+		}
+		m.rules = append(m.rules, rule)
+		m.status = append(m.status, alertStatus{Name: rule.name, Expr: rule.expr})
+	}
+	return m
+}
+
+// Evaluate checks every rule against an INFO sample and returns the rules that started or stopped firing
+func (m *alertManager) Evaluate(info map[string]interface{}, at time.Time) []alertEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var events []alertEvent
+	for i, rule := range m.rules {
+		if rule == nil {
+			continue
+		}
+
+		firing, value, ok := rule.evaluate(info, m.prev)
+		firing = firing && ok
+		st := &m.status[i]
+		st.Value = value
+		if firing == st.Firing {
+			continue
+		}
+
+		st.Firing = firing
+		st.Since = at
+		event := alertEvent{Time: at, Rule: rule.name, Expr: rule.expr, Value: value, Resolved: !firing}
+		m.history.Push(event)
+		events = append(events, event)
+	}
+	m.prev = info
+
+	for _, event := range events {
+		if event.Resolved {
+			logger.Infof("[Alerts] Resolved: %s (%s)", event.Rule, event.Value)
+		} else {
+			logger.Infof("[Alerts] Firing: %s (%s)", event.Rule, event.Value)
+		}
+		if m.hook != "" {
+			go runAlertHook(m.hook, event)
+		}
+	}
+	return events
+}
+
+// Firing returns the rules that are currently firing
+func (m *alertManager) Firing() []alertStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var firing []alertStatus
+	for _, st := range m.status {
+		if st.Firing {
+			firing = append(firing, st)
+		}
+	}
+	return firing
+}
+
+// Status returns the state of every configured rule
+func (m *alertManager) Status() []alertStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]alertStatus(nil), m.status...)
+}
+
+// History returns the alert events, oldest first
+func (m *alertManager) History() []alertEvent {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.history.Items()
+}
+
+// ClearHistory drops the alert events
+func (m *alertManager) ClearHistory() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.history.Clear()
+}
+
+// runAlertHook runs the configured shell command with the event in its environment
+func runAlertHook(hook string, event alertEvent) {
+	ctx, cancel := context.WithTimeout(context.Background(), alertHookTimeout)
+	defer cancel()
+
+	state := "firing"
+	if event.Resolved {
+		state = "resolved"
+	}
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", hook)
+	cmd.Env = append(os.Environ(),
+		"ALERT_NAME="+event.Rule,
+		"ALERT_EXPR="+event.Expr,
+		"ALERT_VALUE="+event.Value,
+		"ALERT_STATE="+state,
+		"ALERT_TIME="+event.Time.Format(time.RFC3339),
+	)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		logger.Errorf("[Alerts] Hook failed for %s: %v: %s", event.Rule, err, strings.TrimSpace(string(out)))
+	}
+}
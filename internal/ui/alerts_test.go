@@ -0,0 +1,90 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestAlertRuleEvaluation tests parsing and evaluating alert rule expressions
+func TestAlertRuleEvaluation(t *testing.T) {
+	info := map[string]interface{}{
+		"used_memory":             int64(950),
+		"maxmemory":               int64(1000),
+		"mem_fragmentation_ratio": 1.7,
+		"master_link_status":      "down",
+		"rejected_connections":    int64(3),
+	}
+	prev := map[string]interface{}{
+		"rejected_connections": int64(1),
+	}
+
+	tests := []struct {
+		expr   string
+		firing bool
+		ok     bool
+	}{
+		{"used_memory > 90% maxmemory", true, true},
+		{"used_memory > 99% maxmemory", false, true},
+		{"mem_fragmentation_ratio > 1.5", true, true},
+		{"mem_fragmentation_ratio>2", false, true},
+		{"master_link_status != up", true, true},
+		{`master_link_status == "down"`, true, true},
+		{"rejected_connections increasing", true, true},
+		{"used_memory < maxmemory", true, true},
+		{"used_memory >= 1kb", false, true},
+		{"missing_field > 1", false, false},
+		{"used_memory > 90% missing_field", false, false},
+	}
+
+	for _, tt := range tests {
+		rule, err := parseAlertRule("", tt.expr)
+		if !assert.NoError(t, err, tt.expr) {
+			continue
+		}
+		firing, _, ok := rule.evaluate(info, prev)
+		assert.Equal(t, tt.ok, ok, tt.expr)
+		assert.Equal(t, tt.firing, firing, tt.expr)
+	}
+
+	for _, expr := range []string{"", "used_memory", "used_memory ~ 1", "used_memory > 90%", "role > \"master\""} {
+		_, err := parseAlertRule("", expr)
+		assert.Error(t, err, expr)
+	}
+}
+
+// TestAlertManagerTransitions tests that alerts are recorded when they fire and resolve
+func TestAlertManagerTransitions(t *testing.T) {
+	logger.Init()
+
+	m := newAlertManager(config.AlertsConfig{
+		Rules: []config.AlertRule{
+			{Name: "fragmentation", Expr: "mem_fragmentation_ratio > 1.5"},
+			{Name: "broken", Expr: "nonsense"},
+		},
+	})
+
+	now := time.Now()
+	assert.Empty(t, m.Evaluate(map[string]interface{}{"mem_fragmentation_ratio": 1.2}, now))
+
+	events := m.Evaluate(map[string]interface{}{"mem_fragmentation_ratio": 1.8}, now.Add(time.Second))
+	assert.Len(t, events, 1)
+	assert.False(t, events[0].Resolved)
+	assert.Len(t, m.Firing(), 1)
+
+	// Still firing: no new event
+	assert.Empty(t, m.Evaluate(map[string]interface{}{"mem_fragmentation_ratio": 1.9}, now.Add(2*time.Second)))
+
+	events = m.Evaluate(map[string]interface{}{"mem_fragmentation_ratio": 1.1}, now.Add(3*time.Second))
+	assert.Len(t, events, 1)
+	assert.True(t, events[0].Resolved)
+	assert.Empty(t, m.Firing())
+	assert.Len(t, m.History(), 2)
+
+	status := m.Status()
+	assert.Len(t, status, 2)
+	assert.Error(t, status[1].Err)
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// AlertsView shows the configured alert rules and the alert history
+type AlertsView struct {
+	redis  *redis.Client
+	alerts *alertManager
+	host   *viewHost
+
+	// Components
+	flex         *tview.Flex
+	rulesTable   *tview.Table
+	historyTable *tview.Table
+}
+
+// NewAlertsView creates a new alerts view
+func NewAlertsView(redisClient *redis.Client, alerts *alertManager) *AlertsView {
+	view := &AlertsView{
+		redis:  redisClient,
+		alerts: alerts,
+	}
+
+	view.setupUI()
+	view.Refresh()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *AlertsView) setupUI() {
+	v.rulesTable = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.rulesTable.SetBorder(true).
+		SetTitle("Alert Rules").
+		SetTitleAlign(tview.AlignLeft)
+
+	v.historyTable = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.historyTable.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]Tab[white] switch table  [yellow]c[white] clear history  [yellow]r[white] refresh  |  rules are set under \"alerts\" in ~/.redis-valkey-tui/config.json")
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.rulesTable, 0, 1, true).
+		AddItem(v.historyTable, 0, 2, false).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyTab {
+			if v.rulesTable.HasFocus() {
+				v.host.setFocus(v.historyTable)
+			} else {
+				v.host.setFocus(v.rulesTable)
+			}
+			return nil
+		}
+
+		switch event.Rune() {
+		case 'c', 'C':
+			v.host.confirm("Clear the alert history?", func() {
+				v.alerts.ClearHistory()
+				v.Refresh()
+			})
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *AlertsView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *AlertsView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// Refresh redraws the rule states and the history
+func (v *AlertsView) Refresh() {
+	v.renderRules()
+	v.renderHistory()
+}
+
+// renderRules fills the rules table with the state of each rule
+func (v *AlertsView) renderRules() {
+	v.rulesTable.Clear()
+	for i, header := range []string{"Rule", "Expression", "State", "Since", "Value"} {
+		v.rulesTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetSelectable(false))
+	}
+
+	rules := v.alerts.Status()
+	if len(rules) == 0 {
+		v.rulesTable.SetCell(1, 0, tview.NewTableCell("No alert rules configured").SetTextColor(tcell.ColorGray))
+		return
+	}
+
+	for i, st := range rules {
+		row := i + 1
+		state, color := "ok", tcell.ColorGreen
+		switch {
+		case st.Err != nil:
+			state, color = st.Err.Error(), tcell.ColorGray
+		case st.Firing:
+			state, color = "FIRING", tcell.ColorRed
+		}
+
+		since := ""
+		if !st.Since.IsZero() {
+			since = fmt.Sprintf("%s (%s ago)", st.Since.Format("15:04:05"), time.Since(st.Since).Round(time.Second))
+		}
+
+		v.rulesTable.SetCell(row, 0, tview.NewTableCell(tview.Escape(st.Name)).SetTextColor(color))
+		v.rulesTable.SetCell(row, 1, tview.NewTableCell(tview.Escape(st.Expr)))
+		v.rulesTable.SetCell(row, 2, tview.NewTableCell(tview.Escape(state)).SetTextColor(color))
+		v.rulesTable.SetCell(row, 3, tview.NewTableCell(since))
+		v.rulesTable.SetCell(row, 4, tview.NewTableCell(tview.Escape(st.Value)))
+	}
+}
+
+// renderHistory fills the history table, newest first
+func (v *AlertsView) renderHistory() {
+	v.historyTable.Clear()
+	for i, header := range []string{"Time", "Rule", "State", "Value"} {
+		v.historyTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetSelectable(false))
+	}
+
+	events := v.alerts.History()
+	v.historyTable.SetTitle(fmt.Sprintf("Alert History (%d)", len(events)))
+
+	for i := range events {
+		e := events[len(events)-1-i]
+		row := i + 1
+		state, color := "FIRING", tcell.ColorRed
+		if e.Resolved {
+			state, color = "resolved", tcell.ColorGreen
+		}
+		v.historyTable.SetCell(row, 0, tview.NewTableCell(e.Time.Format("2006-01-02 15:04:05")))
+		v.historyTable.SetCell(row, 1, tview.NewTableCell(tview.Escape(e.Rule)))
+		v.historyTable.SetCell(row, 2, tview.NewTableCell(state).SetTextColor(color))
+		v.historyTable.SetCell(row, 3, tview.NewTableCell(tview.Escape(e.Value)))
+	}
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
@@ -22,6 +23,14 @@ const (
 	CLIViewType
 	ConfigViewType
 	HelpViewType
+	CompareViewType
+	PubSubViewType
+	StreamViewType
+	SlowLogViewType
+	LatencyViewType
+	ClientsViewType
+	AlertsViewType
+	LuaViewType
 )
 
 // App represents the main application
@@ -31,6 +40,12 @@ type App struct {
 	contentPages *tview.Pages // For managing view switching
 	redis        *redis.Client
 	config       *config.Config
+	databases    int // Database count of the connected server, for :db suggestions
+
+	// Connection in use with the flag, :connect, :db and :protocol overrides of this run,
+	// kept out of config so saving the config never writes them
+	conn     config.RedisConfig
+	readOnly bool // -readonly: every connection of this run refuses writes
 
 	// Views
 	keysView    *KeysView
@@ -39,6 +54,14 @@ type App struct {
 	cliView     *CLIView
 	configView  *ConfigView
 	helpView    *HelpView
+	compareView *CompareView
+	pubsubView  *PubSubView
+	streamView  *MonitorStreamView
+	slowlogView *SlowLogView
+	latencyView *LatencyView
+	clientsView *ClientsView
+	alertsView  *AlertsView
+	luaView     *LuaView
 
 	// Current state
 	currentView ViewType
@@ -47,11 +70,18 @@ type App struct {
 	statusBar   *tview.TextView
 	footerBar   *tview.TextView
 	metrics     *Metrics
+	alerts      *alertManager
 
 	// Help
 	helpVisible bool
 	helpModal   *tview.Modal
 
+	// Dialogs shown on top of the main layout
+	host *viewHost
+
+	// ":" command prompt
+	prompt *commandPrompt
+
 	// Testing flag
 	testMode bool
 
@@ -59,15 +89,21 @@ type App struct {
 	metricsStopChan chan struct{}
 }
 
-// NewApp creates a new application instance
-func NewApp(cfg *config.Config) *App {
+// NewApp creates a new application instance that connects with conn, read-only throughout when readOnly is set
+func NewApp(cfg *config.Config, conn config.RedisConfig, readOnly bool) *App {
 	app := &App{
 		app:         tview.NewApplication(),
 		pages:       tview.NewPages(),
 		config:      cfg,
+		conn:        conn,
+		readOnly:    readOnly,
 		metrics:     NewMetrics(),
+		alerts:      newAlertManager(cfg.Alerts),
 		currentView: KeysViewType,
 	}
+	app.host = newViewHost(app.app, app.pages)
+	app.host.onSwitchView = app.switchView
+	app.prompt = newCommandPrompt(app.host, app.promptCommands, app.executeCommand)
 
 	return app
 }
@@ -76,9 +112,9 @@ func NewApp(cfg *config.Config) *App {
 func (a *App) Run() error {
 	logger.Logger.Println("Starting application with configuration:",
 		fmt.Sprintf("Redis: %s:%d/DB%d, MaxKeys: %d",
-			a.config.Redis.Host,
-			a.config.Redis.Port,
-			a.config.Redis.DB,
+			a.conn.Host,
+			a.conn.Port,
+			a.conn.DB,
 			a.config.UI.MaxKeys))
 
 	// Validate configuration
@@ -87,8 +123,8 @@ func (a *App) Run() error {
 	}
 
 	// Connect to Redis with timeout
-	logger.Logger.Printf("Establishing Redis connection to %s:%d...", a.config.Redis.Host, a.config.Redis.Port)
-	redisClient, err := redis.New(&a.config.Redis)
+	logger.Logger.Printf("Establishing Redis connection to %s:%d...", a.conn.Host, a.conn.Port)
+	redisClient, err := redis.New(&a.conn)
 	if err != nil {
 		logger.Logger.Printf("CRITICAL: Redis connection failed: %v", err)
 		return fmt.Errorf("redis connection failed: %w", err)
@@ -108,6 +144,7 @@ func (a *App) Run() error {
 
 	logger.Logger.Printf("SUCCESS: Connected to Redis server version: %s", version)
 	a.redis = redisClient
+	a.databases = databaseCount(redisClient)
 
 	// Initialize UI components with error handling
 	logger.Logger.Println("Initializing UI components...")
@@ -175,6 +212,8 @@ func (a *App) initializeViews() error {
 			a.app.SetFocus(component)
 		}
 	})
+	a.host.testMode = a.testMode
+	a.keysView.SetHost(a.host)
 
 	logger.Logger.Println("Initializing InfoView...")
 	if a.infoView = NewInfoView(a.redis); a.infoView == nil {
@@ -185,11 +224,14 @@ func (a *App) initializeViews() error {
 	if a.monitorView = NewMonitorView(a.redis); a.monitorView == nil {
 		return fmt.Errorf("failed to create MonitorView")
 	}
+	a.monitorView.SetHost(a.host)
 
 	logger.Logger.Println("Initializing CLIView...")
 	if a.cliView = NewCLIView(a.redis); a.cliView == nil {
 		return fmt.Errorf("failed to create CLIView")
 	}
+	a.cliView.SetHost(a.host)
+	a.cliView.SetHistory(loadCLIHistory(cliHistoryPath(a.conn), a.config.UI.HistorySize))
 
 	logger.Logger.Println("Initializing ConfigView...")
 	if a.configView = NewConfigView(a.config); a.configView == nil {
@@ -201,6 +243,55 @@ func (a *App) initializeViews() error {
 		return fmt.Errorf("failed to create HelpView")
 	}
 
+	logger.Logger.Println("Initializing CompareView...")
+	if a.compareView = NewCompareView(a.redis, a.config); a.compareView == nil {
+		return fmt.Errorf("failed to create CompareView")
+	}
+	a.compareView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing PubSubView...")
+	if a.pubsubView = NewPubSubView(a.redis); a.pubsubView == nil {
+		return fmt.Errorf("failed to create PubSubView")
+	}
+	a.pubsubView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing MonitorStreamView...")
+	if a.streamView = NewMonitorStreamView(a.redis); a.streamView == nil {
+		return fmt.Errorf("failed to create MonitorStreamView")
+	}
+	a.streamView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing SlowLogView...")
+	if a.slowlogView = NewSlowLogView(a.redis); a.slowlogView == nil {
+		return fmt.Errorf("failed to create SlowLogView")
+	}
+	a.slowlogView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing LatencyView...")
+	if a.latencyView = NewLatencyView(a.redis); a.latencyView == nil {
+		return fmt.Errorf("failed to create LatencyView")
+	}
+	a.latencyView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing ClientsView...")
+	if a.clientsView = NewClientsView(a.redis); a.clientsView == nil {
+		return fmt.Errorf("failed to create ClientsView")
+	}
+	a.clientsView.SetHost(a.host)
+
+	logger.Logger.Println("Initializing AlertsView...")
+	if a.alertsView = NewAlertsView(a.redis, a.alerts); a.alertsView == nil {
+		return fmt.Errorf("failed to create AlertsView")
+	}
+	a.alertsView.SetHost(a.host)
+	a.monitorView.SetSampleHandler(a.checkAlerts)
+
+	logger.Logger.Println("Initializing LuaView...")
+	if a.luaView = NewLuaView(a.redis); a.luaView == nil {
+		return fmt.Errorf("failed to create LuaView")
+	}
+	a.luaView.SetHost(a.host)
+
 	logger.Logger.Println("All views initialized successfully")
 	return nil
 }
@@ -216,7 +307,7 @@ func (a *App) setupUI() {
 	a.footerBar = tview.NewTextView().
 		SetDynamicColors(true).
 		SetTextAlign(tview.AlignLeft).
-		SetText("[yellow]Navigation:[white] 1=Keys 2=Info 3=Monitor 4=CLI 5=Config 6=Help | [yellow]Global:[white] ESC=home r=refresh ?=help Ctrl+C=quit")
+		SetText("[yellow]Navigation:[white] 1=Keys 2=Info 3=Monitor 4=CLI 5=Config 6=Help 7=PubSub 8=Stream 9=SlowLog 0=Latency | [yellow]Global:[white] ESC=home :=command r=refresh ?=help Ctrl+C=quit")
 	a.footerBar.SetBorder(true).
 		SetTitle("Shortcuts").
 		SetBorderPadding(0, 0, 1, 1)
@@ -274,6 +365,30 @@ func (a *App) setupLayout() {
 	logger.Tracef("Adding Help view: %p", a.helpView.GetComponent())
 	a.contentPages.AddPage("help_view", a.helpView.GetComponent(), true, false)
 
+	logger.Tracef("Adding Compare view: %p", a.compareView.GetComponent())
+	a.contentPages.AddPage("compare", a.compareView.GetComponent(), true, false)
+
+	logger.Tracef("Adding PubSub view: %p", a.pubsubView.GetComponent())
+	a.contentPages.AddPage("pubsub", a.pubsubView.GetComponent(), true, false)
+
+	logger.Tracef("Adding Stream view: %p", a.streamView.GetComponent())
+	a.contentPages.AddPage("stream", a.streamView.GetComponent(), true, false)
+
+	logger.Tracef("Adding SlowLog view: %p", a.slowlogView.GetComponent())
+	a.contentPages.AddPage("slowlog", a.slowlogView.GetComponent(), true, false)
+
+	logger.Tracef("Adding Latency view: %p", a.latencyView.GetComponent())
+	a.contentPages.AddPage("latency", a.latencyView.GetComponent(), true, false)
+
+	logger.Tracef("Adding Clients view: %p", a.clientsView.GetComponent())
+	a.contentPages.AddPage("clients", a.clientsView.GetComponent(), true, false)
+
+	logger.Tracef("Adding Alerts view: %p", a.alertsView.GetComponent())
+	a.contentPages.AddPage("alerts", a.alertsView.GetComponent(), true, false)
+
+	logger.Tracef("Adding Lua view: %p", a.luaView.GetComponent())
+	a.contentPages.AddPage("lua", a.luaView.GetComponent(), true, false)
+
 	logger.Debug("All views added to content pages")
 
 	// Add the content pages to the main layout
@@ -421,6 +536,94 @@ func (a *App) getCurrentViewForType(viewType ViewType) tview.Primitive {
 		result = a.helpView.GetComponent()
 		logger.Tracef("[getCurrentViewForType] helpView.GetComponent() returned: %p", result)
 
+	case CompareViewType:
+		viewName = "CompareView"
+		logger.Tracef("[getCurrentViewForType] Case CompareViewType - checking a.compareView: %p", a.compareView)
+		if a.compareView == nil {
+			logger.Error("[getCurrentViewForType] compareView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling compareView.GetComponent()")
+		result = a.compareView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] compareView.GetComponent() returned: %p", result)
+
+	case PubSubViewType:
+		viewName = "PubSubView"
+		logger.Tracef("[getCurrentViewForType] Case PubSubViewType - checking a.pubsubView: %p", a.pubsubView)
+		if a.pubsubView == nil {
+			logger.Error("[getCurrentViewForType] pubsubView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling pubsubView.GetComponent()")
+		result = a.pubsubView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] pubsubView.GetComponent() returned: %p", result)
+
+	case StreamViewType:
+		viewName = "MonitorStreamView"
+		logger.Tracef("[getCurrentViewForType] Case StreamViewType - checking a.streamView: %p", a.streamView)
+		if a.streamView == nil {
+			logger.Error("[getCurrentViewForType] streamView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling streamView.GetComponent()")
+		result = a.streamView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] streamView.GetComponent() returned: %p", result)
+
+	case SlowLogViewType:
+		viewName = "SlowLogView"
+		logger.Tracef("[getCurrentViewForType] Case SlowLogViewType - checking a.slowlogView: %p", a.slowlogView)
+		if a.slowlogView == nil {
+			logger.Error("[getCurrentViewForType] slowlogView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling slowlogView.GetComponent()")
+		result = a.slowlogView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] slowlogView.GetComponent() returned: %p", result)
+
+	case LatencyViewType:
+		viewName = "LatencyView"
+		logger.Tracef("[getCurrentViewForType] Case LatencyViewType - checking a.latencyView: %p", a.latencyView)
+		if a.latencyView == nil {
+			logger.Error("[getCurrentViewForType] latencyView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling latencyView.GetComponent()")
+		result = a.latencyView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] latencyView.GetComponent() returned: %p", result)
+
+	case ClientsViewType:
+		viewName = "ClientsView"
+		logger.Tracef("[getCurrentViewForType] Case ClientsViewType - checking a.clientsView: %p", a.clientsView)
+		if a.clientsView == nil {
+			logger.Error("[getCurrentViewForType] clientsView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling clientsView.GetComponent()")
+		result = a.clientsView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] clientsView.GetComponent() returned: %p", result)
+
+	case AlertsViewType:
+		viewName = "AlertsView"
+		logger.Tracef("[getCurrentViewForType] Case AlertsViewType - checking a.alertsView: %p", a.alertsView)
+		if a.alertsView == nil {
+			logger.Error("[getCurrentViewForType] alertsView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling alertsView.GetComponent()")
+		result = a.alertsView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] alertsView.GetComponent() returned: %p", result)
+
+	case LuaViewType:
+		viewName = "LuaView"
+		logger.Tracef("[getCurrentViewForType] Case LuaViewType - checking a.luaView: %p", a.luaView)
+		if a.luaView == nil {
+			logger.Error("[getCurrentViewForType] luaView is nil!")
+			return nil
+		}
+		logger.Tracef("[getCurrentViewForType] Calling luaView.GetComponent()")
+		result = a.luaView.GetComponent()
+		logger.Tracef("[getCurrentViewForType] luaView.GetComponent() returned: %p", result)
+
 	default:
 		viewName = "Default (KeysView)"
 		logger.Warnf("[getCurrentViewForType] Unknown view type: %d, defaulting to KeysView", viewType)
@@ -530,6 +733,7 @@ func (a *App) switchView(view ViewType) {
 			logger.Tracef("[switchView] Debug:   cliView: %p", a.cliView)
 			logger.Tracef("[switchView] Debug:   configView: %p", a.configView)
 			logger.Tracef("[switchView] Debug:   helpView: %p", a.helpView)
+			logger.Tracef("[switchView] Debug:   compareView: %p", a.compareView)
 		}
 	} else {
 		logger.Debug("[switchView] Skipping UI operations due to failed prerequisites:")
@@ -568,6 +772,22 @@ func (a *App) getViewName(view ViewType) string {
 		return "Config"
 	case HelpViewType:
 		return "Help"
+	case CompareViewType:
+		return "Compare"
+	case PubSubViewType:
+		return "PubSub"
+	case StreamViewType:
+		return "Stream"
+	case SlowLogViewType:
+		return "SlowLog"
+	case LatencyViewType:
+		return "Latency"
+	case ClientsViewType:
+		return "Clients"
+	case AlertsViewType:
+		return "Alerts"
+	case LuaViewType:
+		return "Lua"
 	default:
 		return "Unknown"
 	}
@@ -591,6 +811,22 @@ func (a *App) getPageNameForView(view ViewType) string {
 		pageName = "config"
 	case HelpViewType:
 		pageName = "help_view"
+	case CompareViewType:
+		pageName = "compare"
+	case PubSubViewType:
+		pageName = "pubsub"
+	case StreamViewType:
+		pageName = "stream"
+	case SlowLogViewType:
+		pageName = "slowlog"
+	case LatencyViewType:
+		pageName = "latency"
+	case ClientsViewType:
+		pageName = "clients"
+	case AlertsViewType:
+		pageName = "alerts"
+	case LuaViewType:
+		pageName = "lua"
 	default:
 		logger.Warnf("[getPageNameForView] Unknown view type: %d, defaulting to 'keys'", view)
 		pageName = "keys"
@@ -604,7 +840,7 @@ func (a *App) getPageNameForView(view ViewType) string {
 func (a *App) getViewStatus() string {
 	switch a.currentView {
 	case KeysViewType:
-		return fmt.Sprintf("DB:%d", a.config.Redis.DB)
+		return fmt.Sprintf("DB:%d", a.conn.DB)
 	case InfoViewType:
 		return "Server information"
 	case MonitorViewType:
@@ -613,6 +849,22 @@ func (a *App) getViewStatus() string {
 		return "Redis CLI"
 	case ConfigViewType:
 		return "Configuration"
+	case CompareViewType:
+		return "Keyspace comparison"
+	case PubSubViewType:
+		return "Pub/Sub"
+	case StreamViewType:
+		return "MONITOR stream"
+	case SlowLogViewType:
+		return "Slow log"
+	case LatencyViewType:
+		return "Latency monitor"
+	case ClientsViewType:
+		return "Client management"
+	case AlertsViewType:
+		return "Alert rules and history"
+	case LuaViewType:
+		return "Lua scripts and functions"
 	default:
 		return "Ready"
 	}
@@ -626,15 +878,41 @@ func (a *App) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
 	// Only handle specific global keys, let everything else pass through to views
 	switch event.Key() {
 	case tcell.KeyCtrlC:
+		// In the CLI, Ctrl+C stops a blocking or streaming command instead of quitting
+		if a.currentView == CLIViewType && a.cliView.stopCommand() {
+			return nil
+		}
+		// In the Lua workbench it ends a debugging session
+		if a.currentView == LuaViewType && a.luaView.stopDebug() {
+			return nil
+		}
 		logger.Info("Ctrl+C pressed, shutting down application")
 		a.cleanup()
 		a.app.Stop()
 		return nil
+	}
+
+	// Dialogs handle their own keys (including ESC to close)
+	if a.host.hasDialog() {
+		return event
+	}
+
+	switch event.Key() {
 	case tcell.KeyEscape:
+		if a.currentView == CLIViewType && a.cliView.handleEscape() {
+			return nil
+		}
+		if a.currentView == LuaViewType && a.luaView.handleEscape() {
+			return nil
+		}
 		logger.Info("ESC pressed, returning to main screen (Keys view)")
 		a.switchView(KeysViewType)
 		return nil
 	case tcell.KeyCtrlR:
+		// The CLI input uses Ctrl+R for history search
+		if a.currentView == CLIViewType && a.cliView.inputFocused() {
+			return event
+		}
 		logger.Info("Ctrl+R pressed, refreshing current view")
 		a.refresh()
 		return nil
@@ -643,9 +921,10 @@ func (a *App) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
 	// Handle number keys for quick view switching only if no input field has focus
 	currentFocus := a.app.GetFocus()
 
-	// Check if current focus is an InputField - if so, let numbers pass through
-	if _, isInputField := currentFocus.(*tview.InputField); isInputField {
-		return event // Pass through to input field
+	// Check if current focus is a text input - if so, let numbers pass through
+	switch currentFocus.(type) {
+	case *tview.InputField, *tview.TextArea:
+		return event // Pass through to the input
 	}
 
 	switch event.Rune() {
@@ -677,10 +956,30 @@ func (a *App) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
 			logger.Warn("Help view is nil, cannot switch")
 		}
 		return nil
+	case '7':
+		logger.Debug("Number key '7' pressed, switching to PubSub view")
+		a.switchView(PubSubViewType)
+		return nil
+	case '8':
+		logger.Debug("Number key '8' pressed, switching to MONITOR stream view")
+		a.switchView(StreamViewType)
+		return nil
+	case '9':
+		logger.Debug("Number key '9' pressed, switching to SlowLog view")
+		a.switchView(SlowLogViewType)
+		return nil
+	case '0':
+		logger.Debug("Number key '0' pressed, switching to Latency view")
+		a.switchView(LatencyViewType)
+		return nil
 	case '?':
 		logger.Debug("'?' key pressed, showing help modal")
 		a.showHelp()
 		return nil
+	case ':':
+		logger.Debug("':' key pressed, showing command prompt")
+		a.prompt.show()
+		return nil
 	}
 
 	// Let all other keys pass through to the views
@@ -690,12 +989,14 @@ func (a *App) handleGlobalKeys(event *tcell.EventKey) *tcell.EventKey {
 
 // executeCommand executes a command
 func (a *App) executeCommand(command string) {
-	command = strings.TrimSpace(command)
+	command = strings.TrimSpace(strings.TrimPrefix(command, ":"))
 	if command == "" {
 		return
 	}
+	name, arg, _ := strings.Cut(command, " ")
+	arg = strings.TrimSpace(arg)
 
-	switch command {
+	switch name {
 	case "keys":
 		a.switchView(KeysViewType)
 	case "info":
@@ -708,14 +1009,142 @@ func (a *App) executeCommand(command string) {
 		a.switchView(ConfigViewType)
 	case "help":
 		a.switchView(HelpViewType)
+	case "compare":
+		a.switchView(CompareViewType)
+	case "pubsub":
+		a.switchView(PubSubViewType)
+	case "stream":
+		a.switchView(StreamViewType)
+	case "slowlog":
+		a.switchView(SlowLogViewType)
+	case "latency":
+		a.switchView(LatencyViewType)
+	case "clients":
+		a.switchView(ClientsViewType)
+	case "alerts":
+		a.switchView(AlertsViewType)
+	case "lua":
+		a.switchView(LuaViewType)
+		a.luaView.Refresh()
 	case "quit", "q":
 		a.cleanup()
 		a.app.Stop()
 	case "refresh", "r":
 		a.refresh()
+	case "db":
+		db, err := strconv.Atoi(arg)
+		if err != nil || db < 0 {
+			a.host.showMessage("Usage: :db <number>")
+			return
+		}
+		cfg := a.conn
+		cfg.DB = db
+		a.connectTo(cfg)
+	case "protocol":
+		if arg != "2" && arg != "3" {
+			a.host.showMessage("Usage: :protocol 2|3")
+			return
+		}
+		cfg := a.conn
+		cfg.Protocol, _ = strconv.Atoi(arg)
+		a.connectTo(cfg)
+	case "connect":
+		profile, err := a.config.Profile(arg)
+		if err != nil {
+			a.host.showMessage(err.Error())
+			return
+		}
+		if a.readOnly {
+			profile.ReadOnly = true
+		}
+		a.connectTo(*profile)
+	case "filter":
+		a.switchView(KeysViewType)
+		a.keysView.SetFilter(arg)
+	case "history":
+		a.switchView(CLIViewType)
+		a.cliView.showHistoryBrowser()
+	case "script":
+		a.switchView(CLIViewType)
+		a.cliView.showScriptDialog(arg)
 	default:
 		a.statusBar.SetText(fmt.Sprintf("[red]Unknown command: %s", command))
+		a.host.showMessage(fmt.Sprintf("Unknown command: %s", command))
+	}
+}
+
+// promptCommands lists the ":" commands and their argument suggestions for autocomplete
+func (a *App) promptCommands() []promptCommand {
+	commands := []promptCommand{
+		{name: "keys"}, {name: "info"}, {name: "monitor"}, {name: "cli"}, {name: "config"},
+		{name: "help"}, {name: "compare"}, {name: "pubsub"}, {name: "stream"}, {name: "slowlog"},
+		{name: "latency"}, {name: "clients"}, {name: "alerts"}, {name: "lua"}, {name: "history"}, {name: "script"}, {name: "refresh"}, {name: "quit"},
+		{name: "db", args: a.databaseNumbers},
+		{name: "protocol", args: func() []string { return []string{"2", "3"} }},
+		{name: "connect", args: a.config.ProfileNames},
+		{name: "filter", args: a.keysView.KeyPrefixes},
 	}
+	return commands
+}
+
+// databaseNumbers suggests every database the server has
+func (a *App) databaseNumbers() []string {
+	numbers := make([]string, a.databases)
+	for i := range numbers {
+		numbers[i] = strconv.Itoa(i)
+	}
+	return numbers
+}
+
+// databaseCount returns the server's databases setting, or the default of 16 when CONFIG GET is unavailable
+func databaseCount(client *redis.Client) int {
+	if value, err := client.GetConfigValue("databases"); err == nil {
+		if n, err := strconv.Atoi(value); err == nil && n > 0 {
+			return n
+		}
+	}
+	return 16
+}
+
+// connectTo dials another server or database in the background and switches every view to it
+func (a *App) connectTo(cfg config.RedisConfig) {
+	a.statusBar.SetText(fmt.Sprintf("[yellow]Connecting to %s:%d/%d...", cfg.Host, cfg.Port, cfg.DB))
+
+	go func() {
+		err := a.redis.Reconnect(&cfg)
+		databases := 0
+		if err == nil {
+			databases = databaseCount(a.redis)
+		}
+		a.host.queueUpdate(func() {
+			if err != nil {
+				a.statusBar.SetText(fmt.Sprintf("[red]Failed to connect to %s:%d/%d", cfg.Host, cfg.Port, cfg.DB))
+				a.host.showMessage(fmt.Sprintf("Failed to connect to %s:%d/%d: %v", cfg.Host, cfg.Port, cfg.DB, err))
+				return
+			}
+			a.connected(cfg, databases)
+		})
+	}()
+}
+
+// connected resets the views after connectTo switched servers
+func (a *App) connected(cfg config.RedisConfig, databases int) {
+	logger.Infof("Connected to %s:%d/%d", cfg.Host, cfg.Port, cfg.DB)
+	a.conn = cfg
+	a.databases = databases
+
+	// Subscriptions and MONITOR run on their own connections to the old server
+	a.pubsubView.Close()
+	a.streamView.Close()
+	a.cliView.stopCommand()
+	a.cliView.closeSession()
+	a.luaView.stopDebug()
+	a.monitorView.ResetHistory()
+
+	a.cliView.SetHistory(loadCLIHistory(cliHistoryPath(cfg), a.config.UI.HistorySize))
+	a.keysView.Refresh()
+	a.cliView.Refresh()
+	a.refresh()
 }
 
 // showHelp shows the help modal
@@ -743,6 +1172,22 @@ func (a *App) refresh() {
 		a.cliView.Refresh()
 	case ConfigViewType:
 		a.configView.Refresh()
+	case CompareViewType:
+		a.compareView.Refresh()
+	case PubSubViewType:
+		a.pubsubView.Refresh()
+	case StreamViewType:
+		a.streamView.Refresh()
+	case SlowLogViewType:
+		a.slowlogView.Refresh()
+	case LatencyViewType:
+		a.latencyView.Refresh()
+	case ClientsViewType:
+		a.clientsView.Refresh()
+	case AlertsViewType:
+		a.alertsView.Refresh()
+	case LuaViewType:
+		a.luaView.Refresh()
 	}
 
 	a.statusBar.SetText(fmt.Sprintf("[green]%s view[white] - Refreshed", a.getViewName(a.currentView)))
@@ -758,6 +1203,27 @@ func (a *App) cleanup() {
 		a.metricsStopChan = nil
 	}
 
+	// Drop Pub/Sub subscriptions
+	if a.pubsubView != nil {
+		a.pubsubView.Close()
+	}
+
+	// Stop MONITOR
+	if a.streamView != nil {
+		a.streamView.Close()
+	}
+
+	// Stop a blocking command running in the CLI
+	if a.cliView != nil {
+		a.cliView.stopCommand()
+		a.cliView.closeSession()
+	}
+
+	// End a Lua debugging session
+	if a.luaView != nil {
+		a.luaView.stopDebug()
+	}
+
 	// Close Redis connection
 	if a.redis != nil {
 		if err := a.redis.Close(); err != nil {
@@ -779,6 +1245,19 @@ Quick Navigation:
   4           Switch to CLI view
   5           Switch to Config view
   6           Switch to Help view
+  7           Switch to Pub/Sub view
+  8           Switch to MONITOR stream view
+  9           Switch to Slow Log view
+  0           Switch to Latency view
+
+Command Prompt:
+  :           Open the command prompt (Tab completes, ↑/↓ pick a suggestion)
+  :db N       Switch to database N
+  :connect P  Connect to profile P
+  :protocol N Reconnect with RESP2 or RESP3 (HELLO 3)
+  :filter G   Filter keys by glob pattern G (e.g. :filter user:*)
+  :history    Browse the CLI command history
+  :script F   Run a script of CLI commands, loaded from file F if given
 
 Navigation Commands:
   :keys       Switch to Keys view
@@ -787,14 +1266,26 @@ Navigation Commands:
   :cli        Switch to CLI view
   :config     Switch to Config view
   :help       Switch to Help view
+  :compare    Switch to Compare view
+  :pubsub     Switch to Pub/Sub view
+  :stream     Switch to MONITOR stream view
+  :slowlog    Switch to Slow Log view
+  :latency    Switch to Latency view
+  :clients    Switch to Clients view
+  :alerts     Switch to Alerts view
+  :lua        Switch to the Lua script and Functions workbench
 
 Global Commands:
   :quit, :q   Quit application
   :refresh, :r Refresh current view
-  Ctrl+C      Quit application
+  Ctrl+C      Quit application (in the CLI, stops a running blocking command first)
   Ctrl+R      Refresh current view
   ?           Show this help modal
 
+Read-Only Mode (-readonly, or "readonly" in the config):
+  Commands that write are refused and editing actions are disabled;
+  READ-ONLY shows in the header
+
 Keys View:
   d           Delete selected key
   e           Edit selected key
@@ -802,6 +1293,10 @@ Keys View:
   /           Filter keys
   r           Refresh keys
   c           Execute command
+  x           Export keys (JSON lines, CSV, DUMP)
+  i           Import keys from an export file
+  m           Migrate keys to another connection profile
+  D           Compare keyspace with another instance or DB
   Enter       View key details
 
 Info View:
@@ -812,12 +1307,90 @@ Monitor View:
   s           Start/stop monitoring
   c           Clear screen
   r           Refresh metrics
+  m           Open the live MONITOR stream
+  l           Manage clients (kill, pause, no-evict)
+  x           Reset statistics (CONFIG RESETSTAT)
+  e           Toggle error statistics panel
+  a           Open the alert rules and history
+  g           Toggle trend chart / stats table
+  n           Chart the next metric
+  w           Switch trend window (5m / 1h)
+
+MONITOR Stream View:
+  s           Start/stop MONITOR (asks for confirmation)
+  Space       Pause/resume the display
+  f           Filter by command, client, DB and key pattern
+  t           Cycle the auto-stop timer
+  o           Cycle the sort column
+  x           Export shown entries (JSON lines or CSV)
+  c           Clear the buffer
+
+Slow Log View:
+  /           Filter by command
+  o           Sort by duration or newest first
+  t           Set slowlog-log-slower-than and slowlog-max-len
+  x           SLOWLOG RESET (asks for confirmation)
+
+Latency View:
+  d           Toggle LATENCY DOCTOR / history chart
+  x           Reset the selected event (asks for confirmation)
+  X           Reset all events (asks for confirmation)
+  t           Set latency-monitor-threshold
+
+Clients View:
+  /           Filter (user:NAME lib:NAME addr:HOST or any text)
+  o / O       Cycle sort column / reverse order
+  k           Kill selected client (asks for confirmation)
+  K           Kill clients by ID, address, user, type or age
+  p / P       CLIENT PAUSE / CLIENT UNPAUSE
+  n           Toggle CLIENT NO-EVICT for this app's connections
+  Enter       Show every CLIENT LIST field
+
+Alerts View:
+  Tab         Switch between rules and history
+  c           Clear the alert history
 
 CLI View:
   Enter       Execute command
+  Tab         Complete commands, arguments and key names
   ↑/↓         Navigate command history
+  Ctrl+R      Reverse search command history (Esc cancels)
+  Ctrl+O      Browse command history
+  Ctrl+C      Stop a blocking or streaming command, or a running script
+  Ctrl+S      Run a script of commands
+  MULTI       Queue commands on a reserved connection until EXEC or DISCARD
   Ctrl+L      Clear screen
 
+Lua View:
+  Ctrl+G      Run: EVAL the script, FUNCTION LOAD REPLACE the library, or FCALL the selected function
+  Ctrl+P      All actions (EVALSHA, SCRIPT LOAD/EXISTS/FLUSH, FUNCTION DUMP/RESTORE, ...)
+  Ctrl+K      Kill the running script or function (SCRIPT KILL / FUNCTION KILL)
+  Ctrl+T      Switch the editor between the script and the library
+  Ctrl+N      Next pane (Shift+Tab previous)
+  Enter       Open the selected library's code
+  d           Delete the selected library (asks for confirmation)
+
+Lua Debugger (Ctrl+P, g):
+  s / c       Step / continue (Ctrl+G also continues)
+  ↑/↓, b      Move the cursor / toggle a breakpoint on its line (B clears all)
+  p / t       Print local variables / show the backtrace
+  e / r / i   Evaluate Lua, run a redis command, or type any LDB command
+  a           Abort the script (Ctrl+C or q closes the session)
+
+Compare View:
+  n           New comparison (profile or other DB)
+  Enter       Show source and target values side by side
+  r           Re-run the last comparison
+
+Pub/Sub View:
+  Enter       Subscribe to selected channel
+  s           Subscribe to channels or patterns
+  u           Unsubscribe from a channel or pattern
+  U           Unsubscribe from all
+  p           Publish a message
+  /           Filter message log
+  c           Clear message log
+
 Config View:
   s           Save configuration
   r           Reset to defaults
@@ -43,7 +43,7 @@ func TestNewApp(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	assert.NotNil(t, app, "App should not be nil")
 	assert.NotNil(t, app.app, "tview.Application should not be nil")
 	assert.NotNil(t, app.pages, "Pages should not be nil")
@@ -67,7 +67,7 @@ func TestAppInitialization(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 
 	// Test setupUI
 	app.setupUI()
@@ -106,7 +106,7 @@ func TestViewSwitching(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.setupUI()
 	app.redis = newTestRedisClient()
 	if app.redis == nil {
@@ -159,7 +159,7 @@ func TestViewSwitchingLogic(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.testMode = true // Enable test mode to avoid UI operations
 	app.setupUI()
 	app.redis = newTestRedisClient()
@@ -212,7 +212,7 @@ func TestCleanup(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.redis = newTestRedisClient()
 
 	// Create a metrics stop channel (simulating what would happen in real app)
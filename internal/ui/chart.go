@@ -0,0 +1,199 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+)
+
+// chartLevels are the partial block characters used for the top of a bar
+var chartLevels = []rune{' ', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// barChart draws values as vertical bars with a y-axis; values are bucketed (keeping the max) to fit width
+func barChart(values []float64, width, height int, unit string) string {
+	if len(values) == 0 {
+		return "(no data)"
+	}
+	if height < 2 {
+		height = 2
+	}
+
+	// Leave room for the y-axis labels
+	labelWidth := len(formatChartValue(maxValue(values), unit)) + 1
+	columns := width - labelWidth - 1
+	if columns < 1 {
+		columns = 1
+	}
+	values = bucketMax(values, columns)
+
+	top := maxValue(values)
+	if top <= 0 {
+		top = 1
+	}
+
+	var b strings.Builder
+	for row := height; row >= 1; row-- {
+		label := ""
+		if row == height {
+			label = formatChartValue(top, unit)
+		} else if row == 1 {
+			label = formatChartValue(0, unit)
+		}
+		fmt.Fprintf(&b, "%*s│", labelWidth, label)
+
+		for _, v := range values {
+			// Each row holds eight levels of the bar
+			filled := v / top * float64(height)
+			level := int((filled - float64(row-1)) * 8)
+			if level < 0 {
+				level = 0
+			}
+			if level > 8 {
+				level = 8
+			}
+			b.WriteRune(chartLevels[level])
+		}
+		b.WriteByte('\n')
+	}
+	fmt.Fprintf(&b, "%*s└%s", labelWidth, "", strings.Repeat("─", len(values)))
+	return b.String()
+}
+
+// lineChart draws values as a line with box-drawing characters; labels are formatted by format
+func lineChart(values []float64, width, height int, format func(float64) string) string {
+	if len(values) == 0 {
+		return "(no data yet)"
+	}
+	if height < 2 {
+		height = 2
+	}
+
+	low, high := values[0], values[0]
+	for _, v := range values {
+		if v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+
+	labelWidth := len(format(high))
+	if w := len(format(low)); w > labelWidth {
+		labelWidth = w
+	}
+	columns := width - labelWidth - 1
+	if columns < 2 {
+		columns = 2
+	}
+	values = bucketMax(values, columns)
+
+	// Map each value to a row, 0 being the bottom
+	rowOf := func(v float64) int {
+		if high == low {
+			return height / 2
+		}
+		return int((v - low) / (high - low) * float64(height-1))
+	}
+
+	grid := make([][]rune, height)
+	for i := range grid {
+		grid[i] = []rune(strings.Repeat(" ", len(values)))
+	}
+
+	prev := rowOf(values[0])
+	grid[prev][0] = '─'
+	for x := 1; x < len(values); x++ {
+		y := rowOf(values[x])
+		switch {
+		case y == prev:
+			grid[y][x] = '─'
+		case y > prev:
+			grid[prev][x] = '╯'
+			for r := prev + 1; r < y; r++ {
+				grid[r][x] = '│'
+			}
+			grid[y][x] = '╭'
+		default:
+			grid[prev][x] = '╮'
+			for r := y + 1; r < prev; r++ {
+				grid[r][x] = '│'
+			}
+			grid[y][x] = '╰'
+		}
+		prev = y
+	}
+
+	var b strings.Builder
+	for row := height - 1; row >= 0; row-- {
+		label := ""
+		if row == height-1 {
+			label = format(high)
+		} else if row == 0 {
+			label = format(low)
+		}
+		fmt.Fprintf(&b, "%*s┤%s\n", labelWidth, label, string(grid[row]))
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// sparkline draws values in a single line of block characters
+func sparkline(values []float64, width int) string {
+	if len(values) == 0 {
+		return ""
+	}
+	values = bucketMax(values, width)
+
+	low, high := values[0], values[0]
+	for _, v := range values {
+		if v < low {
+			low = v
+		}
+		if v > high {
+			high = v
+		}
+	}
+
+	var b strings.Builder
+	for _, v := range values {
+		level := 4
+		if high > low {
+			level = 1 + int((v-low)/(high-low)*7)
+		}
+		b.WriteRune(chartLevels[level])
+	}
+	return b.String()
+}
+
+// bucketMax reduces values to at most n points, keeping the maximum of each bucket
+func bucketMax(values []float64, n int) []float64 {
+	if n < 1 || len(values) <= n {
+		return values
+	}
+
+	out := make([]float64, n)
+	for i := range out {
+		start := i * len(values) / n
+		end := (i + 1) * len(values) / n
+		out[i] = maxValue(values[start:end])
+	}
+	return out
+}
+
+// maxValue returns the largest value, or 0 for an empty slice
+func maxValue(values []float64) float64 {
+	top := 0.0
+	for i, v := range values {
+		if i == 0 || v > top {
+			top = v
+		}
+	}
+	return top
+}
+
+// formatChartValue formats an axis label
+func formatChartValue(v float64, unit string) string {
+	if v == float64(int64(v)) {
+		return fmt.Sprintf("%d%s", int64(v), unit)
+	}
+	return fmt.Sprintf("%.1f%s", v, unit)
+}
@@ -0,0 +1,287 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+)
+
+const (
+	// keyCompletionLimit is the most key names scanned for one prefix
+	keyCompletionLimit = 1000
+
+	// keyCompletionMaxScans is the most SCAN calls for one prefix, so a prefix matching few
+	// keys does not walk a large keyspace
+	keyCompletionMaxScans = 50
+
+	// keyCompletionDelay is how long typing must pause before a scan starts
+	keyCompletionDelay = 150 * time.Millisecond
+
+	// keyCompletionTTL is how long scanned key names are reused
+	keyCompletionTTL = 30 * time.Second
+)
+
+// errKeyLimit stops a completion scan once enough keys were found
+var errKeyLimit = errors.New("key limit reached")
+
+// cliCompletion describes what Tab can do with the word before the cursor
+type cliCompletion struct {
+	base       string   // Text before the word being completed
+	word       string   // The partial word
+	candidates []string // Command names, subcommands or argument tokens
+	keys       bool     // The word is a key name
+}
+
+// completeCLI works out the completions of the last word of a command line
+func completeCLI(table redis.CommandTable, text string) cliCompletion {
+	words, err := cmdline.Split(text)
+	if err != nil {
+		return cliCompletion{}
+	}
+
+	c := cliCompletion{base: text}
+	if text != "" && !strings.ContainsAny(text[len(text)-1:], " \t") {
+		c.word = words[len(words)-1]
+		words = words[:len(words)-1]
+		if strings.ContainsAny(c.word, " \t") {
+			return cliCompletion{} // Inside a quoted argument
+		}
+		c.base = text[:strings.LastIndexAny(text, " \t")+1]
+	}
+
+	pos := len(words)
+	if pos == 0 {
+		c.candidates = matchWord(c.word, table.Names())
+		return c
+	}
+
+	spec := table[strings.ToUpper(words[0])]
+	if spec == nil {
+		return c
+	}
+	if pos == 1 && len(spec.Subcommands) > 0 {
+		subs := make([]string, 0, len(spec.Subcommands))
+		for name := range spec.Subcommands {
+			subs = append(subs, name)
+		}
+		sort.Strings(subs)
+		c.candidates = matchWord(c.word, subs)
+		return c
+	}
+
+	spec = table.Lookup(words)
+	used := make(map[string]bool)
+	for _, w := range words[spec.Depth():] {
+		used[strings.ToUpper(w)] = true
+	}
+	seen := make(map[string]bool)
+	var tokens []string
+	for _, arg := range spec.Args {
+		for _, token := range arg.Tokens() {
+			if !used[token] && !seen[token] {
+				seen[token] = true
+				tokens = append(tokens, token)
+			}
+		}
+	}
+	c.candidates = matchWord(c.word, tokens)
+	c.keys = spec.IsKey(pos)
+	return c
+}
+
+// matchWord returns the candidates starting with word, ignoring case and following its case
+func matchWord(word string, candidates []string) []string {
+	lower := word != "" && word == strings.ToLower(word)
+	var matches []string
+	for _, c := range candidates {
+		if strings.HasPrefix(strings.ToUpper(c), strings.ToUpper(word)) {
+			if lower {
+				c = strings.ToLower(c)
+			}
+			matches = append(matches, c)
+		}
+	}
+	return matches
+}
+
+// quoteCompletion quotes a key name when the tokenizer would otherwise split or unescape it
+func quoteCompletion(key string) string {
+	if key == "" {
+		return redis.QuoteArg(key)
+	}
+	for i := 0; i < len(key); i++ {
+		c := key[i]
+		if c <= ' ' || c >= 0x7f || c == '"' || c == '\'' {
+			return redis.QuoteArg(key)
+		}
+	}
+	return key
+}
+
+// commonPrefix returns the longest prefix all words share
+func commonPrefix(words []string) string {
+	if len(words) == 0 {
+		return ""
+	}
+	prefix := words[0]
+	for _, w := range words[1:] {
+		for !strings.HasPrefix(w, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+	return prefix
+}
+
+// keyNameCache remembers the key names SCAN found for each completed prefix
+type keyNameCache struct {
+	redis *redis.Client
+
+	mu      sync.Mutex
+	entries map[string]*keyNameEntry
+	cancel  context.CancelFunc // Stops the latest scan
+}
+
+// keyNameEntry is the result of one prefix scan
+type keyNameEntry struct {
+	keys     []string
+	complete bool // Every matching key fit under keyCompletionLimit and keyCompletionMaxScans
+	loaded   time.Time
+	loading  bool
+}
+
+// newKeyNameCache creates an empty cache
+func newKeyNameCache(redisClient *redis.Client) *keyNameCache {
+	return &keyNameCache{
+		redis:   redisClient,
+		entries: make(map[string]*keyNameEntry),
+	}
+}
+
+// Lookup returns the cached keys starting with prefix, scanning in the background when
+// no fresh scan covers it; onLoad runs once that scan finishes. A new scan replaces the
+// one still waiting or running for another prefix.
+func (c *keyNameCache) Lookup(prefix string, onLoad func()) []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e := c.entries[prefix]; e != nil && (e.loading || time.Since(e.loaded) < keyCompletionTTL) {
+		return filterKeyNames(e.keys, prefix)
+	}
+	// A complete scan of a shorter prefix already has every match
+	for p, e := range c.entries {
+		if e.complete && strings.HasPrefix(prefix, p) && time.Since(e.loaded) < keyCompletionTTL {
+			return filterKeyNames(e.keys, prefix)
+		}
+	}
+
+	c.evictStale()
+	if c.cancel != nil {
+		c.cancel()
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.cancel = cancel
+
+	e := &keyNameEntry{loading: true}
+	c.entries[prefix] = e
+	go c.scan(ctx, prefix, e, onLoad)
+	return nil
+}
+
+// Clear forgets every scan and stops the running one, e.g. after switching databases
+func (c *keyNameCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.entries = make(map[string]*keyNameEntry)
+}
+
+// evictStale drops the finished scans older than keyCompletionTTL; callers hold mu
+func (c *keyNameCache) evictStale() {
+	for p, e := range c.entries {
+		if !e.loading && time.Since(e.loaded) >= keyCompletionTTL {
+			delete(c.entries, p)
+		}
+	}
+}
+
+// scan fills an entry with the keys matching prefix once typing pauses, unless ctx is
+// cancelled first; a cancelled scan drops its entry
+func (c *keyNameCache) scan(ctx context.Context, prefix string, e *keyNameEntry, onLoad func()) {
+	select {
+	case <-time.After(keyCompletionDelay):
+	case <-ctx.Done():
+		c.drop(prefix, e)
+		return
+	}
+
+	var keys []string
+	complete := true
+	scans := 0
+	err := c.redis.ScanKeys(ctx, globEscape(prefix)+"*", "", func(batch []string) error {
+		keys = append(keys, batch...)
+		scans++
+		if len(keys) >= keyCompletionLimit || scans >= keyCompletionMaxScans {
+			complete = false
+			return errKeyLimit
+		}
+		return nil
+	})
+	if ctx.Err() != nil {
+		c.drop(prefix, e)
+		return
+	}
+	if err != nil && !errors.Is(err, errKeyLimit) {
+		logger.Errorf("Failed to scan keys for completion: %v", err)
+		complete = false
+	}
+	sort.Strings(keys)
+
+	c.mu.Lock()
+	e.keys, e.complete, e.loaded, e.loading = keys, complete, time.Now(), false
+	c.mu.Unlock()
+
+	onLoad()
+}
+
+// drop removes an entry unless a newer scan of the same prefix replaced it
+func (c *keyNameCache) drop(prefix string, e *keyNameEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries[prefix] == e {
+		delete(c.entries, prefix)
+	}
+}
+
+// filterKeyNames returns the keys starting with prefix
+func filterKeyNames(keys []string, prefix string) []string {
+	var matches []string
+	for _, k := range keys {
+		if strings.HasPrefix(k, prefix) {
+			matches = append(matches, k)
+		}
+	}
+	return matches
+}
+
+// globEscape escapes the characters SCAN MATCH treats as wildcards
+func globEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case '*', '?', '[', ']', '\\':
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
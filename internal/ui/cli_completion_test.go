@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis/redistest"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCLICompletion tests completing command names, subcommands, tokens and key positions
+func TestCLICompletion(t *testing.T) {
+	table := redistest.CommandTable()
+
+	c := completeCLI(table, "")
+	assert.Equal(t, []string{"CONFIG", "GET", "MGET", "SET"}, c.candidates)
+
+	c = completeCLI(table, "se")
+	assert.Equal(t, "", c.base)
+	assert.Equal(t, []string{"set"}, c.candidates)
+
+	c = completeCLI(table, "CONFIG ")
+	assert.Equal(t, []string{"GET", "SET"}, c.candidates)
+
+	c = completeCLI(table, "get user:")
+	assert.Equal(t, "get ", c.base)
+	assert.Equal(t, "user:", c.word)
+	assert.True(t, c.keys)
+
+	c = completeCLI(table, `SET k "hello world" `)
+	assert.Equal(t, []string{"NX", "XX", "GET"}, c.candidates)
+	assert.False(t, c.keys)
+
+	c = completeCLI(table, `SET k v NX g`)
+	assert.Equal(t, []string{"get"}, c.candidates)
+
+	c = completeCLI(table, `SET k "unterminated`)
+	assert.Empty(t, c.candidates)
+
+	assert.Equal(t, "user:", commonPrefix([]string{"user:1", "user:2", "user:"}))
+	assert.Equal(t, `"a b"`, quoteCompletion("a b"))
+	assert.Equal(t, "a:b", quoteCompletion("a:b"))
+	assert.Equal(t, `user\*\?`, globEscape("user*?"))
+}
+
+// TestKeyNameCacheCancelsScans tests that a new prefix replaces the waiting scan and stale entries go
+func TestKeyNameCacheCancelsScans(t *testing.T) {
+	// Without a client every scan that gets past the delay would panic
+	c := newKeyNameCache(nil)
+	c.entries["old:"] = &keyNameEntry{keys: []string{"old:1"}, complete: true, loaded: time.Now().Add(-time.Hour)}
+	c.entries["fresh:"] = &keyNameEntry{keys: []string{"fresh:1"}, complete: true, loaded: time.Now()}
+
+	assert.Nil(t, c.Lookup("u", func() {}))
+	assert.Nil(t, c.Lookup("us", func() {}))
+	assert.Equal(t, []string{"fresh:1"}, c.Lookup("fresh:1", func() {}))
+	c.Clear()
+
+	time.Sleep(2 * keyCompletionDelay)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.Empty(t, c.entries)
+}
+
+// TestKeyNameCacheEvictsStale tests that expired scans are dropped when a new scan starts
+func TestKeyNameCacheEvictsStale(t *testing.T) {
+	c := newKeyNameCache(nil)
+	c.entries["old:"] = &keyNameEntry{loaded: time.Now().Add(-time.Hour)}
+	c.entries["fresh:"] = &keyNameEntry{loaded: time.Now()}
+
+	c.Lookup("new:", func() {})
+	c.mu.Lock()
+	_, old := c.entries["old:"]
+	_, fresh := c.entries["fresh:"]
+	_, loading := c.entries["new:"]
+	c.mu.Unlock()
+	c.Clear()
+
+	assert.False(t, old)
+	assert.True(t, fresh)
+	assert.True(t, loading)
+}
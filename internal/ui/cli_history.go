@@ -0,0 +1,249 @@
+package ui
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+)
+
+const (
+	// redactedArg replaces secrets in the history file
+	redactedArg = "***"
+
+	// defaultCLIHistorySize is used when the configured history size is not positive
+	defaultCLIHistorySize = 1000
+)
+
+// cliHistory is the CLI command history, saved to a file per connection profile. Commands
+// added in this session keep their passwords in memory; the file only gets redacted copies.
+type cliHistory struct {
+	path    string // Empty keeps the history in memory only
+	limit   int
+	entries []string // Oldest first
+}
+
+// cliHistoryPath returns the history file of a connection, named after its profile or address
+func cliHistoryPath(cfg config.RedisConfig) string {
+	name := cfg.Name
+	if name == "" {
+		name = fmt.Sprintf("%s_%d", cfg.Host, cfg.Port)
+	}
+	name = unsafeFileChars.ReplaceAllString(name, "_")
+	return filepath.Join(config.Dir(), "history", name+".history")
+}
+
+// unsafeFileChars matches characters kept out of history file names
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// loadCLIHistory reads a history file, keeping the newest limit entries
+func loadCLIHistory(path string, limit int) *cliHistory {
+	if limit <= 0 {
+		limit = defaultCLIHistorySize
+	}
+	h := &cliHistory{path: path, limit: limit}
+	if path == "" {
+		return h
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			logger.Errorf("Failed to read CLI history: %v", err)
+		}
+		return h
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		if line := scanner.Text(); line != "" {
+			h.entries = append(h.entries, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Errorf("Failed to read CLI history: %v", err)
+	}
+
+	// Compact a file that grew past the cap
+	if len(h.entries) > h.limit {
+		h.entries = h.entries[len(h.entries)-h.limit:]
+		if err := h.save(); err != nil {
+			logger.Errorf("Failed to compact CLI history: %v", err)
+		}
+	}
+	return h
+}
+
+// Entries returns the commands, oldest first
+func (h *cliHistory) Entries() []string {
+	return h.entries
+}
+
+// Add records a command, skipping a repeat of the previous one; the file gets it with its
+// secrets redacted
+func (h *cliHistory) Add(command string) error {
+	if n := len(h.entries); n > 0 && h.entries[n-1] == command {
+		return nil
+	}
+	h.entries = append(h.entries, command)
+	if len(h.entries) > h.limit {
+		h.entries = h.entries[len(h.entries)-h.limit:]
+	}
+	return h.appendLine(redactCommand(command))
+}
+
+// Search returns the index of the newest entry at or before from that contains query, or -1
+func (h *cliHistory) Search(query string, from int) int {
+	if from >= len(h.entries) {
+		from = len(h.entries) - 1
+	}
+	query = strings.ToLower(query)
+	for i := from; i >= 0; i-- {
+		if strings.Contains(strings.ToLower(h.entries[i]), query) {
+			return i
+		}
+	}
+	return -1
+}
+
+// appendLine adds one command to the history file
+func (h *cliHistory) appendLine(command string) error {
+	if h.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0700); err != nil {
+		return fmt.Errorf("failed to create history directory: %w", err)
+	}
+	f, err := os.OpenFile(h.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open history file: %w", err)
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, command); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	return nil
+}
+
+// save rewrites the history file with the current entries, redacted
+func (h *cliHistory) save() error {
+	lines := make([]string, len(h.entries))
+	for i, entry := range h.entries {
+		lines[i] = redactCommand(entry)
+	}
+	data := strings.Join(lines, "\n") + "\n"
+	if err := os.WriteFile(h.path, []byte(data), 0600); err != nil {
+		return fmt.Errorf("failed to write history file: %w", err)
+	}
+	return nil
+}
+
+// secretConfigParams are CONFIG SET parameters whose values are secrets
+var secretConfigParams = map[string]bool{
+	"requirepass":              true,
+	"masterauth":               true,
+	"tls-key-file-pass":        true,
+	"tls-client-key-file-pass": true,
+}
+
+// secretArgs returns the positions of the passwords in AUTH, HELLO, MIGRATE, CONFIG SET
+// and ACL SETUSER commands, with the redacted form of each
+func secretArgs(args []string) map[int]string {
+	secrets := make(map[int]string)
+	redact := func(i int) {
+		if i < len(args) {
+			secrets[i] = redactedArg
+		}
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "AUTH":
+		// AUTH [username] password
+		if len(args) > 1 {
+			redact(len(args) - 1)
+		}
+	case "HELLO":
+		// HELLO protover AUTH username password
+		for i := 1; i < len(args); i++ {
+			if strings.EqualFold(args[i], "AUTH") {
+				redact(i + 2)
+			}
+		}
+	case "MIGRATE":
+		// MIGRATE ... AUTH password | AUTH2 username password
+		for i := 1; i < len(args); i++ {
+			switch strings.ToUpper(args[i]) {
+			case "AUTH":
+				redact(i + 1)
+			case "AUTH2":
+				redact(i + 2)
+			}
+		}
+	case "CONFIG":
+		if len(args) > 1 && strings.EqualFold(args[1], "SET") {
+			for i := 2; i+1 < len(args); i += 2 {
+				if secretConfigParams[strings.ToLower(args[i])] {
+					redact(i + 1)
+				}
+			}
+		}
+	case "ACL":
+		// Rules like >password, <password, #hash and !hash carry secrets
+		if len(args) > 1 && strings.EqualFold(args[1], "SETUSER") {
+			for i := 3; i < len(args); i++ {
+				if args[i] != "" && strings.ContainsRune("><#!", rune(args[i][0])) {
+					secrets[i] = args[i][:1] + redactedArg
+				}
+			}
+		}
+	}
+	return secrets
+}
+
+// redactCommand replaces passwords with ***; a command that cannot be parsed keeps only its name
+func redactCommand(command string) string {
+	args, err := cmdline.Split(command)
+	if err != nil {
+		if fields := strings.Fields(command); len(fields) > 1 {
+			return fields[0] + " " + redactedArg
+		}
+		return command
+	}
+	if len(args) == 0 {
+		return command
+	}
+
+	secrets := secretArgs(args)
+	if len(secrets) == 0 {
+		return command
+	}
+	for i, arg := range args {
+		if secret, ok := secrets[i]; ok {
+			arg = secret
+		}
+		args[i] = quoteCompletion(arg)
+	}
+	return strings.Join(args, " ")
+}
+
+// hasRedactedSecret reports whether a command still holds a password redacted by the history,
+// which must not be sent as a literal ***
+func hasRedactedSecret(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	for i, secret := range secretArgs(args) {
+		if args[i] == secret {
+			return true
+		}
+	}
+	return false
+}
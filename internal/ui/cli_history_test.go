@@ -0,0 +1,72 @@
+package ui
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCLIHistoryPersistence tests saving, capping and searching the CLI history
+func TestCLIHistoryPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "history", "test.history")
+
+	h := loadCLIHistory(path, 3)
+	for _, cmd := range []string{"GET a", "SET b 1", "SET b 1", "AUTH secret", "INCR counter", "GET b"} {
+		assert.NoError(t, h.Add(cmd))
+	}
+	assert.Equal(t, []string{"AUTH secret", "INCR counter", "GET b"}, h.Entries())
+
+	data, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+
+	// Reloading keeps the newest entries and compacts the file to the cap
+	h = loadCLIHistory(path, 3)
+	assert.Equal(t, []string{"AUTH ***", "INCR counter", "GET b"}, h.Entries())
+	data, err = os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "AUTH ***\nINCR counter\nGET b\n", string(data))
+
+	assert.Equal(t, 2, h.Search("get", 2))
+	assert.Equal(t, 1, h.Search("INCR", 2))
+	assert.Equal(t, -1, h.Search("get", 1))
+	assert.Equal(t, -1, h.Search("missing", 2))
+}
+
+// TestRedactCommand tests that secrets are removed before commands are saved
+func TestRedactCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		redacted string
+	}{
+		{"AUTH secret", "AUTH ***"},
+		{"auth default secret", "auth default ***"},
+		{"AUTH", "AUTH"},
+		{"HELLO 3 AUTH default secret SETNAME cli", "HELLO 3 AUTH default *** SETNAME cli"},
+		{"MIGRATE host 6379 key 0 5000 AUTH secret", "MIGRATE host 6379 key 0 5000 AUTH ***"},
+		{"MIGRATE host 6379 key 0 5000 AUTH2 user secret", "MIGRATE host 6379 key 0 5000 AUTH2 user ***"},
+		{`CONFIG SET requirepass "s3cret pass" maxmemory 1gb`, "CONFIG SET requirepass *** maxmemory 1gb"},
+		{"ACL SETUSER alice on >secret ~* +@all", "ACL SETUSER alice on >*** ~* +@all"},
+		{`SET k "hello world"`, `SET k "hello world"`},
+		{"CONFIG GET requirepass", "CONFIG GET requirepass"},
+		{`AUTH "unterminated secret`, "AUTH ***"},
+		{`SET k "unterminated`, "SET ***"},
+		{`"unterminated`, `"unterminated`},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.redacted, redactCommand(tt.command), tt.command)
+	}
+}
+
+// TestHasRedactedSecret tests which recalled commands still hold a redacted password
+func TestHasRedactedSecret(t *testing.T) {
+	assert.True(t, hasRedactedSecret([]string{"AUTH", "***"}))
+	assert.True(t, hasRedactedSecret([]string{"HELLO", "3", "AUTH", "default", "***"}))
+	assert.True(t, hasRedactedSecret([]string{"ACL", "SETUSER", "alice", "on", ">***"}))
+	assert.False(t, hasRedactedSecret([]string{"AUTH", "secret"}))
+	assert.False(t, hasRedactedSecret([]string{"SET", "k", "***"}))
+	assert.False(t, hasRedactedSecret(nil))
+}
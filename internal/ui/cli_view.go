@@ -1,34 +1,74 @@
 package ui
 
 import (
+	"context"
 	"fmt"
-	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"os"
 	"strings"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
 
+const (
+	// cliMaxOutputLines is how many lines the output keeps; older ones scroll away
+	cliMaxOutputLines = 5000
+
+	// cliStreamFlushInterval is how often replies of a streaming command are drawn
+	cliStreamFlushInterval = 100 * time.Millisecond
+)
+
 // CLIView represents the CLI view
 type CLIView struct {
 	redis *redis.Client
+	host  *viewHost
 
 	// Components
 	flex   *tview.Flex
 	input  *tview.InputField
 	output *tview.TextView
+	hint   *tview.TextView
 
 	// State
-	history      []string
+	history      *cliHistory
 	historyIndex int
+
+	// Reverse history search (Ctrl+R)
+	searching   bool
+	searchQuery string
+	searchIndex int
+	searchSaved string // Input text before the search started
+
+	// Blocking or streaming command running on a dedicated connection
+	running *redis.CommandStream
+
+	// Script run in the background, cancelled with Ctrl+C
+	scriptCancel context.CancelFunc
+
+	// Connection reserved by WATCH or MULTI until EXEC, DISCARD or UNWATCH
+	session  *redis.Session
+	inMulti  bool
+	txQueued int
+
+	// Completion
+	commands        redis.CommandTable
+	loadingCommands bool
+	keyNames        *keyNameCache
+	completing      bool // The completion list is open
 }
 
 // NewCLIView creates a new CLI view
 func NewCLIView(redisClient *redis.Client) *CLIView {
 	view := &CLIView{
 		redis:        redisClient,
-		history:      []string{},
+		history:      loadCLIHistory("", 0),
 		historyIndex: 0,
+		keyNames:     newKeyNameCache(redisClient),
 	}
 
 	view.setupUI()
@@ -46,6 +86,29 @@ func (v *CLIView) setupUI() {
 		SetDoneFunc(v.handleCommand)
 
 	v.input.SetInputCapture(v.handleInput)
+	v.input.SetChangedFunc(v.updateHint)
+	v.input.SetAutocompleteFunc(func(text string) []string {
+		if !v.completing {
+			return nil
+		}
+		entries := v.completionEntries(text)
+		if len(entries) == 0 {
+			v.completing = false
+		}
+		return entries
+	})
+	v.input.SetAutocompletedFunc(func(text string, index, source int) bool {
+		if source == tview.AutocompletedNavigate {
+			return false
+		}
+		v.completing = false
+		v.input.SetText(completeCLI(v.commands, v.input.GetText()).base + text + " ")
+		return true
+	})
+
+	v.hint = tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[gray]Tab completes commands, subcommands, arguments and key names")
 
 	v.input.SetBorder(true).
 		SetTitle("Command Input").
@@ -54,6 +117,7 @@ func (v *CLIView) setupUI() {
 	// Create output view
 	v.output = tview.NewTextView().
 		SetDynamicColors(true).
+		SetMaxLines(cliMaxOutputLines).
 		SetScrollable(true).
 		SetWordWrap(true)
 
@@ -109,6 +173,7 @@ func (v *CLIView) setupUI() {
 	v.flex = tview.NewFlex().
 		SetDirection(tview.FlexRow).
 		AddItem(v.output, 0, 1, false).
+		AddItem(v.hint, 1, 0, false).
 		AddItem(v.input, 3, 0, true)
 
 	// Make output focusable
@@ -126,6 +191,17 @@ func (v *CLIView) setupUI() {
 	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyTab:
+			if v.searching {
+				v.endSearch()
+			}
+			// Complete the current word; the open completion list takes Tab itself
+			if v.input.HasFocus() && v.completing {
+				return event
+			}
+			if v.input.HasFocus() && strings.TrimSpace(v.input.GetText()) != "" {
+				v.complete()
+				return nil
+			}
 			// Switch focus between input and output
 			if v.input.HasFocus() {
 				v.output.Focus(nil)
@@ -161,8 +237,21 @@ func (v *CLIView) GetComponent() tview.Primitive {
 	return v.flex
 }
 
+// SetHost sets the host used to update the UI from background loads
+func (v *CLIView) SetHost(host *viewHost) {
+	v.host = host
+}
+
 // handleInput handles input for navigation and special keys
 func (v *CLIView) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	// The open completion list uses the arrow keys
+	if v.completing {
+		return event
+	}
+	if v.searching {
+		return v.handleSearchKey(event)
+	}
+
 	// Only handle history navigation if input field is focused
 	// This allows arrow keys to work for scrolling when output is focused
 	switch event.Key() {
@@ -183,6 +272,15 @@ func (v *CLIView) handleInput(event *tcell.EventKey) *tcell.EventKey {
 	case tcell.KeyCtrlL:
 		v.clearOutput()
 		return nil
+	case tcell.KeyCtrlR:
+		v.startSearch()
+		return nil
+	case tcell.KeyCtrlO:
+		v.showHistoryBrowser()
+		return nil
+	case tcell.KeyCtrlS:
+		v.showScriptDialog("")
+		return nil
 	}
 
 	// Pass through global navigation keys and other keys
@@ -199,10 +297,16 @@ func (v *CLIView) handleCommand(key tcell.Key) {
 	if command == "" {
 		return
 	}
+	if v.running != nil || v.scriptCancel != nil {
+		v.appendOutput("[yellow]A command is still running; press Ctrl+C to stop it[white]")
+		return
+	}
 
 	// Add to history
-	v.history = append(v.history, command)
-	v.historyIndex = len(v.history)
+	if err := v.history.Add(command); err != nil {
+		logger.Errorf("Failed to save CLI history: %v", err)
+	}
+	v.historyIndex = len(v.history.Entries())
 
 	// Clear input
 	v.input.SetText("")
@@ -217,10 +321,76 @@ func (v *CLIView) executeCommand(command string) {
 	v.appendOutput(fmt.Sprintf("[green]redis> %s[white]", command))
 
 	// Parse command
-	parts := strings.Fields(command)
+	parts, err := cmdline.Split(command)
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red]Error: %s[white]", err))
+		return
+	}
 	if len(parts) == 0 {
 		return
 	}
+	if hasRedactedSecret(parts) {
+		v.appendOutput("[yellow]The password of this command was redacted in the history; type it again instead of ***[white]")
+		return
+	}
+
+	// Dangerous commands wait for typed confirmation
+	guardCommand(v.host, v.redis, parts, func() {
+		v.host.setFocus(v.input)
+		v.runCommand(parts)
+	}, func() {
+		v.host.setFocus(v.input)
+		v.appendOutput("[yellow]Cancelled[white]")
+	})
+}
+
+// cliRoute is the connection the CLI sends a command on
+type cliRoute int
+
+// CLI routes
+const (
+	routePooled    cliRoute = iota // A pooled connection
+	routeSession                   // The connection reserved for WATCH and MULTI
+	routeDedicated                 // Its own connection, so it can be stopped
+	routeRefused                   // Not run: it would block the reserved connection
+)
+
+// commandRoute picks the connection for a command. Subscribing, monitoring and blocking
+// would take over a pooled connection, so they get their own; inside MULTI they are only
+// queued, but after WATCH they would block the reserved connection where nothing can stop them.
+func commandRoute(args []string, inSession, inMulti bool) cliRoute {
+	if redis.IsStreamingCommand(args) || redis.IsBlockingCommand(args) {
+		switch {
+		case inMulti:
+			return routeSession
+		case inSession:
+			return routeRefused
+		}
+		return routeDedicated
+	}
+
+	// Transactions keep their state on one connection
+	switch name := strings.ToUpper(args[0]); {
+	case inSession, name == "MULTI", name == "WATCH":
+		return routeSession
+	}
+	return routePooled
+}
+
+// runCommand sends a parsed command on the connection it needs
+func (v *CLIView) runCommand(parts []string) {
+	switch commandRoute(parts, v.session != nil, v.inMulti) {
+	case routeSession:
+		v.runInSession(parts)
+		return
+	case routeDedicated:
+		v.startCommand(parts)
+		return
+	case routeRefused:
+		v.appendOutput(fmt.Sprintf("[yellow]%s would block the connection reserved by WATCH; queue it after MULTI or run UNWATCH first[white]",
+			tview.Escape(strings.ToUpper(parts[0]))))
+		return
+	}
 
 	// Execute command
 	result, err := v.redis.ExecuteCommand(parts[0], interfaceSlice(parts[1:])...)
@@ -230,35 +400,291 @@ func (v *CLIView) executeCommand(command string) {
 	}
 
 	// Format and display result
-	v.appendOutput(v.formatResult(result))
-}
-
-// formatResult formats a Redis command result
-func (v *CLIView) formatResult(result interface{}) string {
-	switch v := result.(type) {
-	case string:
-		return fmt.Sprintf("[yellow]%s[white]", v)
-	case int64:
-		return fmt.Sprintf("[cyan]%d[white]", v)
-	case []interface{}:
-		if len(v) == 0 {
-			return "[gray](empty list)[white]"
+	v.appendOutput(formatResult(result))
+}
+
+// runInSession runs a command on the connection reserved for WATCH and MULTI, tracking the transaction state
+func (v *CLIView) runInSession(args []string) {
+	if v.session == nil {
+		v.session = v.redis.NewSession()
+	}
+
+	reply, err := v.session.Do(args...)
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red]Error: %s[white]", tview.Escape(err.Error())))
+	} else {
+		v.appendOutput(formatResult(reply))
+	}
+
+	switch strings.ToUpper(args[0]) {
+	case "MULTI":
+		if err == nil {
+			v.inMulti = true
+			v.txQueued = 0
+		}
+	case "EXEC", "DISCARD", "RESET":
+		v.closeSession()
+	case "UNWATCH":
+		if !v.inMulti {
+			v.closeSession()
+		}
+	default:
+		if v.inMulti && err == nil {
+			v.txQueued++
 		}
+	}
+	v.input.SetLabel(v.promptLabel())
+}
 
-		formatted := "[cyan]"
-		for i, item := range v {
-			if i > 0 {
-				formatted += "\n"
-			}
-			formatted += fmt.Sprintf("%d) %v", i+1, item)
+// closeSession releases the connection reserved for a transaction
+func (v *CLIView) closeSession() {
+	if v.session == nil {
+		return
+	}
+	if err := v.session.Close(); err != nil {
+		logger.Errorf("Failed to release transaction connection: %v", err)
+	}
+	v.session = nil
+	v.inMulti = false
+	v.txQueued = 0
+	v.input.SetLabel(v.promptLabel())
+}
+
+// promptLabel shows whether commands are being queued for a transaction
+func (v *CLIView) promptLabel() string {
+	switch {
+	case v.inMulti:
+		return fmt.Sprintf("redis(TX %d queued)> ", v.txQueued)
+	case v.session != nil:
+		return "redis(WATCH)> "
+	}
+	return "redis> "
+}
+
+// showScriptDialog asks for commands to run, pasted or loaded from a file
+func (v *CLIView) showScriptDialog(path string) {
+	const name = "cli-script"
+	form := v.host.newDialogForm(name, "Run Script (one command per line, # comments)")
+
+	form.AddTextArea("Commands", "", 0, 12, 0, nil)
+	form.AddInputField("File", path, 0, nil, nil)
+	form.AddCheckbox("Stop on first error", true, nil)
+	form.AddDropDown("Mode", []string{"Sequential", "Pipeline", "MULTI/EXEC"}, 0, nil)
+
+	commands := form.GetFormItemByLabel("Commands").(*tview.TextArea)
+	load := func() {
+		file := strings.TrimSpace(form.GetFormItemByLabel("File").(*tview.InputField).GetText())
+		if file == "" {
+			return
+		}
+		data, err := os.ReadFile(file)
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Failed to read script: %v", err))
+			return
+		}
+		commands.SetText(string(data), false)
+	}
+
+	form.AddButton("Run", func() {
+		cmds, err := redis.ParseScript(commands.GetText())
+		if err != nil {
+			v.host.showMessage(err.Error())
+			return
 		}
-		formatted += "[white]"
-		return formatted
-	case nil:
-		return "[gray](nil)[white]"
+		if len(cmds) == 0 {
+			v.host.showMessage("The script has no commands")
+			return
+		}
+		stopOnError := form.GetFormItemByLabel("Stop on first error").(*tview.Checkbox).IsChecked()
+		mode, _ := form.GetFormItemByLabel("Mode").(*tview.DropDown).GetCurrentOption()
+		v.host.closeDialog(name)
+		v.host.setFocus(v.input)
+		run := func() {
+			v.host.setFocus(v.input)
+			v.runScript(cmds, redis.ScriptMode(mode), stopOnError)
+		}
+
+		// One typed confirmation covers every flagged command of the script
+		args := make([][]string, len(cmds))
+		for i, cmd := range cmds {
+			args[i] = cmd.Args
+		}
+		checkCommands(v.host, v.redis, args, func(dangers []*redis.Danger) {
+			var flagged []string
+			var first *redis.Danger
+			var firstLine int
+			for i, danger := range dangers {
+				if danger != nil {
+					if first == nil {
+						first, firstLine = danger, cmds[i].Line
+					}
+					flagged = append(flagged, fmt.Sprint(cmds[i].Line))
+				}
+			}
+			if first == nil {
+				run()
+				return
+			}
+			text := fmt.Sprintf("Line %d: %s", firstLine, dangerText(first))
+			if len(flagged) > 1 {
+				text = fmt.Sprintf("Lines %s need confirmation.\n%s", strings.Join(flagged, ", "), text)
+			}
+			v.host.confirmTyped(text, first.Confirm, run, func() {
+				v.host.setFocus(v.input)
+				v.appendOutput("[yellow]Script cancelled[white]")
+			})
+		})
+	})
+	form.AddButton("Load File", load)
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+		v.host.setFocus(v.input)
+	})
+
+	load()
+	v.host.showDialog(name, form, 90, 24)
+}
+
+// runScript runs script commands in the background, printing each reply next to its command
+func (v *CLIView) runScript(cmds []redis.ScriptCommand, mode redis.ScriptMode, stopOnError bool) {
+	if v.running != nil || v.scriptCancel != nil {
+		v.appendOutput("[yellow]A command is still running; press Ctrl+C to stop it[white]")
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.scriptCancel = cancel
+	v.output.SetTitle("Output - script running (Ctrl+C to stop)")
+	v.appendOutput(fmt.Sprintf("[yellow]Running %d commands (%s)[white]", len(cmds), mode))
+
+	go func() {
+		failed := 0
+		err := v.redis.RunScript(ctx, cmds, mode, stopOnError, func(r redis.ScriptResult) {
+			if r.Err != nil && r.Err != redis.ErrSkipped {
+				failed++
+			}
+			text := v.formatScriptResult(r)
+			v.host.queueUpdate(func() { v.appendOutput(text) })
+		})
+
+		v.host.queueUpdate(func() {
+			switch {
+			case err != nil:
+				v.appendOutput(fmt.Sprintf("[yellow]Script stopped: %s[white]", tview.Escape(err.Error())))
+			case failed > 0:
+				v.appendOutput(fmt.Sprintf("[red]Script finished with %d failed commands[white]", failed))
+			default:
+				v.appendOutput("[green]Script finished[white]")
+			}
+			cancel()
+			v.scriptCancel = nil
+			v.output.SetTitle("Output")
+		})
+	}()
+}
+
+// formatScriptResult shows a script command with its line number and reply
+func (v *CLIView) formatScriptResult(r redis.ScriptResult) string {
+	head := fmt.Sprintf("[gray]%4d[white] [green]%s[white]", r.Command.Line, tview.Escape(r.Command.Text))
+
+	var reply string
+	switch {
+	case r.Err == redis.ErrSkipped:
+		reply = "[gray](skipped)[white]"
+	case r.Err != nil:
+		reply = fmt.Sprintf("[red](error) %s[white]", tview.Escape(r.Err.Error()))
 	default:
-		return fmt.Sprintf("[yellow]%v[white]", v)
+		reply = formatResult(r.Reply)
+	}
+
+	// Single-line replies go on the command's line, longer ones below it
+	if !strings.Contains(reply, "\n") {
+		return head + " [gray]->[white] " + reply
 	}
+	return head + "\n" + reply
+}
+
+// startCommand runs a blocking or streaming command on its own connection, showing replies as they arrive
+func (v *CLIView) startCommand(args []string) {
+	stream, err := v.redis.StartCommand(context.Background(), args)
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red]Error: %s[white]", tview.Escape(err.Error())))
+		return
+	}
+	v.running = stream
+	v.output.SetTitle(fmt.Sprintf("Output - %s running (Ctrl+C to stop)", strings.ToUpper(args[0])))
+	if redis.IsStreamingCommand(args) {
+		v.appendOutput("[gray]Reading messages... (press Ctrl+C to stop)[white]")
+	}
+
+	go func() {
+		// Replies are batched so a busy MONITOR does not redraw for every line
+		ticker := time.NewTicker(cliStreamFlushInterval)
+		defer ticker.Stop()
+
+		var pending []string
+		flush := func() {
+			if len(pending) == 0 {
+				return
+			}
+			text := strings.Join(pending, "\n")
+			pending = nil
+			v.host.queueUpdate(func() { v.appendOutput(text) })
+		}
+
+		replies := stream.Replies()
+		for replies != nil {
+			select {
+			case reply, ok := <-replies:
+				if !ok {
+					replies = nil
+					break
+				}
+				if replyErr, isErr := reply.(redis.ReplyError); isErr {
+					pending = append(pending, fmt.Sprintf("[red](error) %s[white]", tview.Escape(replyErr.Error())))
+				} else {
+					pending = append(pending, formatResult(reply))
+				}
+			case <-ticker.C:
+				flush()
+			}
+		}
+		flush()
+
+		v.host.queueUpdate(func() {
+			if err := stream.Err(); err != nil {
+				v.appendOutput(fmt.Sprintf("[red]Error: %s[white]", tview.Escape(err.Error())))
+			}
+			v.finishCommand(stream)
+		})
+	}()
+}
+
+// finishCommand clears the running state once a command's stream has ended
+func (v *CLIView) finishCommand(stream *redis.CommandStream) {
+	if v.running != stream {
+		return
+	}
+	v.running = nil
+	v.output.SetTitle("Output")
+}
+
+// stopCommand cancels the running blocking or streaming command or script, reporting whether there was one
+func (v *CLIView) stopCommand() bool {
+	if v.scriptCancel != nil {
+		v.scriptCancel()
+		return true
+	}
+	if v.running == nil {
+		return false
+	}
+	stream := v.running
+	if err := stream.Stop(); err != nil {
+		logger.Errorf("Failed to stop command: %v", err)
+	}
+	v.appendOutput("[gray](stopped)[white]")
+	v.finishCommand(stream)
+	return true
 }
 
 // appendOutput appends text to the output
@@ -275,7 +701,8 @@ func (v *CLIView) appendOutput(text string) {
 
 // navigateHistory navigates through command history
 func (v *CLIView) navigateHistory(direction int) {
-	if len(v.history) == 0 {
+	entries := v.history.Entries()
+	if len(entries) == 0 {
 		return
 	}
 
@@ -283,13 +710,103 @@ func (v *CLIView) navigateHistory(direction int) {
 
 	if v.historyIndex < 0 {
 		v.historyIndex = 0
-	} else if v.historyIndex >= len(v.history) {
-		v.historyIndex = len(v.history)
+	} else if v.historyIndex >= len(entries) {
+		v.historyIndex = len(entries)
 		v.input.SetText("")
 		return
 	}
 
-	v.input.SetText(v.history[v.historyIndex])
+	v.input.SetText(entries[v.historyIndex])
+}
+
+// SetHistory switches to the history of another connection profile
+func (v *CLIView) SetHistory(history *cliHistory) {
+	v.history = history
+	v.historyIndex = len(history.Entries())
+}
+
+// startSearch starts a reverse incremental history search, or finds the next older match
+func (v *CLIView) startSearch() {
+	if !v.searching {
+		v.searching = true
+		v.searchQuery = ""
+		v.searchSaved = v.input.GetText()
+		v.searchIndex = len(v.history.Entries()) - 1
+	} else if v.searchIndex > 0 {
+		if i := v.history.Search(v.searchQuery, v.searchIndex-1); i >= 0 {
+			v.searchIndex = i
+		}
+	}
+	v.showSearch()
+}
+
+// handleSearchKey edits the search query; keys that move or run the command accept the match
+func (v *CLIView) handleSearchKey(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyCtrlR:
+		v.startSearch()
+		return nil
+	case tcell.KeyCtrlG:
+		v.cancelSearch()
+		return nil
+	case tcell.KeyBackspace, tcell.KeyBackspace2:
+		if v.searchQuery != "" {
+			v.searchQuery = v.searchQuery[:len(v.searchQuery)-1]
+			v.searchIndex = len(v.history.Entries()) - 1
+			v.search()
+		}
+		return nil
+	case tcell.KeyRune:
+		v.searchQuery += string(event.Rune())
+		v.search()
+		return nil
+	}
+
+	// Enter runs the match, any other key edits it
+	v.endSearch()
+	return event
+}
+
+// search finds the newest match of the query at or before the current match
+func (v *CLIView) search() {
+	if i := v.history.Search(v.searchQuery, v.searchIndex); i >= 0 {
+		v.searchIndex = i
+	}
+	v.showSearch()
+}
+
+// showSearch shows the query in the label and the match in the input
+func (v *CLIView) showSearch() {
+	entries := v.history.Entries()
+	match := ""
+	found := v.searchIndex >= 0 && v.searchIndex < len(entries) &&
+		strings.Contains(strings.ToLower(entries[v.searchIndex]), strings.ToLower(v.searchQuery))
+	if found {
+		match = entries[v.searchIndex]
+	}
+
+	label := fmt.Sprintf("(reverse-i-search)`%s': ", v.searchQuery)
+	if !found && v.searchQuery != "" {
+		label = fmt.Sprintf("(failed reverse-i-search)`%s': ", v.searchQuery)
+	}
+	v.input.SetLabel(label)
+	v.input.SetText(match)
+}
+
+// endSearch keeps the matched command in the input
+func (v *CLIView) endSearch() {
+	v.searching = false
+	v.input.SetLabel(v.promptLabel())
+	if v.searchIndex >= 0 {
+		v.historyIndex = v.searchIndex
+	}
+}
+
+// cancelSearch restores the input as it was before the search
+func (v *CLIView) cancelSearch() {
+	v.endSearch()
+	v.historyIndex = len(v.history.Entries())
+	v.input.SetText(v.searchSaved)
 }
 
 // clearOutput clears the output
@@ -312,8 +829,13 @@ Examples:
   PING
 
 Navigation:
+  Tab       Complete commands, arguments and key names
   ↑/↓       Navigate command history
+  Ctrl+R    Search command history
+  Ctrl+O    Browse command history
   Ctrl+L    Clear screen
+  Ctrl+C    Stop a blocking or streaming command (BLPOP, SUBSCRIBE, MONITOR...)
+  Ctrl+S    Run a script of commands (sequential, pipeline or MULTI/EXEC)
   Enter     Execute command
 
 Type your commands below:
@@ -321,9 +843,211 @@ Type your commands below:
 	v.output.SetText(welcome)
 }
 
-// Refresh refreshes the CLI view
+// Refresh reloads the command table and key names, e.g. after connecting to another server
 func (v *CLIView) Refresh() {
-	// Nothing to refresh in CLI view
+	v.commands = nil
+	v.keyNames.Clear()
+	v.updateHint(v.input.GetText())
+}
+
+// loadCommands fetches the server's command table in the background
+func (v *CLIView) loadCommands() {
+	if v.loadingCommands {
+		return
+	}
+	v.loadingCommands = true
+	go func() {
+		table, err := v.redis.Commands()
+		v.host.queueUpdate(func() {
+			v.loadingCommands = false
+			if err != nil {
+				v.hint.SetText(fmt.Sprintf("[red]No completion: %s", tview.Escape(err.Error())))
+				return
+			}
+			v.commands = table
+			v.updateHint(v.input.GetText())
+		})
+	}()
+}
+
+// updateHint shows the syntax of the command being typed
+func (v *CLIView) updateHint(text string) {
+	if v.commands == nil {
+		v.loadCommands()
+		v.hint.SetText("[gray]Loading command table...")
+		return
+	}
+
+	words, err := cmdline.Split(text)
+	if err != nil {
+		words = strings.Fields(text)
+	}
+	if len(words) == 0 {
+		v.hint.SetText("[gray]Tab completes commands, subcommands, arguments and key names")
+		return
+	}
+
+	spec := v.commands.Lookup(words)
+	if spec == nil {
+		v.hint.SetText(fmt.Sprintf("[red]Unknown command %s", tview.Escape(strings.ToUpper(words[0]))))
+		return
+	}
+	hint := "[yellow]" + tview.Escape(spec.Syntax())
+	if spec.Summary != "" {
+		hint += "  [gray]" + tview.Escape(spec.Summary)
+	}
+	v.hint.SetText(hint)
+}
+
+// completionEntries returns the words Tab can insert for text, key names included
+func (v *CLIView) completionEntries(text string) []string {
+	if v.commands == nil {
+		v.loadCommands()
+		return nil
+	}
+
+	c := completeCLI(v.commands, text)
+	entries := c.candidates
+	if c.keys {
+		keys := v.keyNames.Lookup(c.word, func() {
+			// Complete again once the scan finishes, unless the user moved on
+			v.host.queueUpdate(func() {
+				if v.input.GetText() == text {
+					v.complete()
+				}
+			})
+		})
+		for _, k := range keys {
+			entries = append(entries, quoteCompletion(k))
+		}
+	}
+	return entries
+}
+
+// complete inserts the only completion, or extends the word as far as the completions agree and lists them
+func (v *CLIView) complete() {
+	text := v.input.GetText()
+	entries := v.completionEntries(text)
+	if len(entries) == 0 {
+		return
+	}
+
+	base := completeCLI(v.commands, text).base
+	if len(entries) == 1 {
+		v.completing = false
+		v.input.SetText(base + entries[0] + " ")
+		v.input.Autocomplete()
+		return
+	}
+	if prefix := commonPrefix(entries); len(base+prefix) > len(text) {
+		v.input.SetText(base + prefix)
+	}
+	v.completing = true
+	v.input.Autocomplete()
+}
+
+// showHistoryBrowser lists the command history, newest first, to re-run or edit an entry
+func (v *CLIView) showHistoryBrowser() {
+	const name = "cli-history"
+
+	filter := tview.NewInputField().
+		SetLabel("Filter: ").
+		SetFieldWidth(0)
+	table := tview.NewTable().
+		SetSelectable(true, false)
+
+	render := func(query string) {
+		table.Clear()
+		entries := v.history.Entries()
+		row := 0
+		for i := len(entries) - 1; i >= 0; i-- {
+			if query != "" && !strings.Contains(strings.ToLower(entries[i]), strings.ToLower(query)) {
+				continue
+			}
+			table.SetCell(row, 0, tview.NewTableCell(fmt.Sprintf("%5d", i+1)).SetTextColor(tcell.ColorGray))
+			table.SetCell(row, 1, tview.NewTableCell(tview.Escape(entries[i])).SetReference(entries[i]).SetExpansion(1))
+			row++
+		}
+		if row == 0 {
+			table.SetCell(0, 1, tview.NewTableCell("No matching commands").SetTextColor(tcell.ColorGray).SetSelectable(false))
+		}
+		table.Select(0, 0).ScrollToBeginning()
+	}
+	selected := func() (string, bool) {
+		row, _ := table.GetSelection()
+		command, ok := table.GetCell(row, 1).GetReference().(string)
+		return command, ok
+	}
+	use := func(command string, run bool) {
+		v.host.closeDialog(name)
+		v.host.setFocus(v.input)
+		v.input.SetText(command)
+		if run {
+			v.handleCommand(tcell.KeyEnter)
+		}
+	}
+
+	filter.SetChangedFunc(render)
+	filter.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEscape {
+			v.host.closeDialog(name)
+			v.host.setFocus(v.input)
+			return
+		}
+		v.host.setFocus(table)
+	})
+	table.SetSelectedFunc(func(row, column int) {
+		if command, ok := selected(); ok {
+			use(command, true)
+		}
+	})
+	table.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch {
+		case event.Key() == tcell.KeyEscape:
+			v.host.closeDialog(name)
+			v.host.setFocus(v.input)
+			return nil
+		case event.Rune() == '/':
+			v.host.setFocus(filter)
+			return nil
+		case event.Rune() == 'e':
+			if command, ok := selected(); ok {
+				use(command, false)
+			}
+			return nil
+		}
+		return event
+	})
+
+	flex := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(filter, 1, 0, false).
+		AddItem(table, 0, 1, true)
+	flex.SetBorder(true).
+		SetTitle(fmt.Sprintf(" Command History (%d) - Enter run, e edit, / filter, Esc close ", len(v.history.Entries()))).
+		SetTitleAlign(tview.AlignLeft)
+
+	render("")
+	v.host.showDialog(name, flex, 90, 25)
+	v.host.setFocus(table)
+}
+
+// handleEscape closes the completion list or cancels a history search, reporting whether it did
+func (v *CLIView) handleEscape() bool {
+	switch {
+	case v.completing:
+		v.completing = false
+		v.input.Autocomplete()
+		return true
+	case v.searching:
+		v.cancelSearch()
+		return true
+	}
+	return false
+}
+
+// inputFocused reports whether the command input has keyboard focus
+func (v *CLIView) inputFocused() bool {
+	return v.input.HasFocus()
 }
 
 // interfaceSlice converts string slice to interface slice
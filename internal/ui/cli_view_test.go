@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCommandRoute tests which connection CLI commands run on, in and out of transactions
+func TestCommandRoute(t *testing.T) {
+	tests := []struct {
+		args      []string
+		inSession bool
+		inMulti   bool
+		route     cliRoute
+	}{
+		{[]string{"GET", "k"}, false, false, routePooled},
+		{[]string{"BLPOP", "k", "0"}, false, false, routeDedicated},
+		{[]string{"subscribe", "news"}, false, false, routeDedicated},
+		{[]string{"MULTI"}, false, false, routeSession},
+		{[]string{"watch", "k"}, false, false, routeSession},
+
+		// After WATCH commands stay on the reserved connection, except those that would block it
+		{[]string{"GET", "k"}, true, false, routeSession},
+		{[]string{"BLPOP", "k", "0"}, true, false, routeRefused},
+		{[]string{"XREAD", "BLOCK", "0", "STREAMS", "s", "$"}, true, false, routeRefused},
+		{[]string{"SUBSCRIBE", "news"}, true, false, routeRefused},
+		{[]string{"MONITOR"}, true, false, routeRefused},
+
+		// Inside MULTI they are only queued
+		{[]string{"BLPOP", "k", "0"}, true, true, routeSession},
+		{[]string{"SUBSCRIBE", "news"}, true, true, routeSession},
+		{[]string{"EXEC"}, true, true, routeSession},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.route, commandRoute(tt.args, tt.inSession, tt.inMulti), "%v session=%v multi=%v", tt.args, tt.inSession, tt.inMulti)
+	}
+}
@@ -0,0 +1,470 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/dustin/go-humanize"
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// clientColumn is a sortable column of the clients table
+type clientColumn struct {
+	title string
+	value func(c redis.ClientInfo) string
+	less  func(a, b redis.ClientInfo) bool
+}
+
+// clientColumns are the columns of the clients table in display order
+var clientColumns = []clientColumn{
+	{"ID", func(c redis.ClientInfo) string { return c.ID }, func(a, b redis.ClientInfo) bool { return atoi64(a.ID) < atoi64(b.ID) }},
+	{"Address", func(c redis.ClientInfo) string { return c.Address }, func(a, b redis.ClientInfo) bool { return a.Address < b.Address }},
+	{"Name", func(c redis.ClientInfo) string { return c.Name }, func(a, b redis.ClientInfo) bool { return a.Name < b.Name }},
+	{"User", func(c redis.ClientInfo) string { return c.User }, func(a, b redis.ClientInfo) bool { return a.User < b.User }},
+	{"Library", clientLibrary, func(a, b redis.ClientInfo) bool { return clientLibrary(a) < clientLibrary(b) }},
+	{"Age", func(c redis.ClientInfo) string { return (time.Duration(c.Age) * time.Second).String() }, func(a, b redis.ClientInfo) bool { return a.Age < b.Age }},
+	{"Idle", func(c redis.ClientInfo) string { return (time.Duration(c.Idle) * time.Second).String() }, func(a, b redis.ClientInfo) bool { return a.Idle < b.Idle }},
+	{"Flags", func(c redis.ClientInfo) string { return c.Flags }, func(a, b redis.ClientInfo) bool { return a.Flags < b.Flags }},
+	{"DB", func(c redis.ClientInfo) string { return strconv.Itoa(c.DB) }, func(a, b redis.ClientInfo) bool { return a.DB < b.DB }},
+	{"Cmd", func(c redis.ClientInfo) string { return c.LastCommand }, func(a, b redis.ClientInfo) bool { return a.LastCommand < b.LastCommand }},
+	{"Sub", func(c redis.ClientInfo) string {
+		return fmt.Sprintf("%d/%d/%d", c.Subscriptions, c.PatternSubs, c.ShardSubs)
+	}, func(a, b redis.ClientInfo) bool {
+		return a.Subscriptions+a.PatternSubs+a.ShardSubs < b.Subscriptions+b.PatternSubs+b.ShardSubs
+	}},
+	{"QBuf", func(c redis.ClientInfo) string { return humanize.IBytes(uint64(c.QueryBuf)) }, func(a, b redis.ClientInfo) bool { return a.QueryBuf < b.QueryBuf }},
+	{"OMem", func(c redis.ClientInfo) string { return humanize.IBytes(uint64(c.OutputMem)) }, func(a, b redis.ClientInfo) bool { return a.OutputMem < b.OutputMem }},
+	{"TotMem", func(c redis.ClientInfo) string { return humanize.IBytes(uint64(c.TotalMem)) }, func(a, b redis.ClientInfo) bool { return a.TotalMem < b.TotalMem }},
+}
+
+// ClientsView lists connected clients and manages them with CLIENT KILL, PAUSE and NO-EVICT
+type ClientsView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex   *tview.Flex
+	filter *tview.InputField
+	table  *tview.Table
+
+	// State
+	clients   []redis.ClientInfo
+	shown     []redis.ClientInfo
+	sortIndex int
+	sortDesc  bool
+}
+
+// NewClientsView creates a new clients view
+func NewClientsView(redisClient *redis.Client) *ClientsView {
+	view := &ClientsView{
+		redis:     redisClient,
+		sortIndex: 13, // TotMem
+		sortDesc:  true,
+	}
+
+	view.setupUI()
+	view.Refresh()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *ClientsView) setupUI() {
+	v.filter = tview.NewInputField().
+		SetLabel("Filter: ").
+		SetPlaceholder("user:<name> lib:<name> addr:<host:port> or any text").
+		SetFieldWidth(0).
+		SetChangedFunc(func(text string) {
+			v.render()
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			v.host.setFocus(v.table)
+		})
+
+	v.table = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.table.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	v.table.SetSelectedFunc(func(row, col int) {
+		if c, ok := v.selectedClient(); ok {
+			v.showDetails(c)
+		}
+	})
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]/[white] filter  [yellow]o/O[white] sort column/direction  [yellow]k[white] kill selected  [yellow]K[white] kill by filter  [yellow]p/P[white] pause/unpause  [yellow]n[white] NO-EVICT for this app  [yellow]Enter[white] all fields  [yellow]r[white] refresh")
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.filter, 1, 0, false).
+		AddItem(v.table, 0, 1, true).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.filter.HasFocus() {
+			return event
+		}
+
+		switch event.Rune() {
+		case '/':
+			v.host.setFocus(v.filter)
+			return nil
+		case 'o':
+			v.sortIndex = (v.sortIndex + 1) % len(clientColumns)
+			v.render()
+			return nil
+		case 'O':
+			v.sortDesc = !v.sortDesc
+			v.render()
+			return nil
+		case 'k':
+			v.killSelected()
+			return nil
+		case 'K':
+			v.showKillDialog()
+			return nil
+		case 'p':
+			v.showPauseDialog()
+			return nil
+		case 'P':
+			if readOnlyBlocked(v.host, v.redis) {
+				return nil
+			}
+			v.host.confirm("Resume all clients with CLIENT UNPAUSE?", func() {
+				if err := v.redis.UnpauseClients(); err != nil {
+					v.host.showMessage(err.Error())
+				}
+			})
+			return nil
+		case 'n', 'N':
+			v.toggleNoEvict()
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *ClientsView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *ClientsView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// Refresh reloads CLIENT LIST
+func (v *ClientsView) Refresh() {
+	clients, err := v.redis.GetClientList()
+	if err != nil {
+		logger.Errorf("[ClientsView] %v", err)
+		v.clients = nil
+		v.render()
+		v.table.SetCell(1, 0, tview.NewTableCell("ERROR").SetTextColor(tcell.ColorRed))
+		v.table.SetCell(1, 1, tview.NewTableCell(err.Error()).SetTextColor(tcell.ColorRed))
+		return
+	}
+
+	v.clients = clients
+	v.render()
+}
+
+// render fills the table with the filtered and sorted clients
+func (v *ClientsView) render() {
+	selectedID := ""
+	if c, ok := v.selectedClient(); ok {
+		selectedID = c.ID
+	}
+
+	v.shown = v.shown[:0]
+	for _, c := range v.clients {
+		if matchClientFilter(c, v.filter.GetText()) {
+			v.shown = append(v.shown, c)
+		}
+	}
+	column := clientColumns[v.sortIndex]
+	sort.SliceStable(v.shown, func(i, j int) bool {
+		if v.sortDesc {
+			return column.less(v.shown[j], v.shown[i])
+		}
+		return column.less(v.shown[i], v.shown[j])
+	})
+
+	v.table.Clear()
+	for i, col := range clientColumns {
+		title := col.title
+		if i == v.sortIndex {
+			title += map[bool]string{true: " ↓", false: " ↑"}[v.sortDesc]
+		}
+		v.table.SetCell(0, i,
+			tview.NewTableCell(title).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	selectedRow := 1
+	for i, c := range v.shown {
+		row := i + 1
+		own := v.redis.IsOwnConnection(c.ID)
+		for j, col := range clientColumns {
+			text := col.value(c)
+			if j == 0 && own {
+				// Mark connections opened by this application
+				text += "*"
+			}
+			cell := tview.NewTableCell(tview.Escape(text))
+			if own {
+				cell.SetTextColor(tcell.ColorGray)
+			}
+			v.table.SetCell(row, j, cell)
+		}
+		if c.ID == selectedID {
+			selectedRow = row
+		}
+	}
+
+	noEvict := ""
+	if v.redis.NoEvict() {
+		noEvict = " | NO-EVICT on for this app"
+	}
+	v.table.SetTitle(fmt.Sprintf("Clients (%d of %d) | * = this app%s", len(v.shown), len(v.clients), noEvict))
+	if len(v.shown) > 0 {
+		v.table.Select(selectedRow, 0)
+	}
+}
+
+// selectedClient returns the client in the selected row
+func (v *ClientsView) selectedClient() (redis.ClientInfo, bool) {
+	row, _ := v.table.GetSelection()
+	if row < 1 || row > len(v.shown) {
+		return redis.ClientInfo{}, false
+	}
+	return v.shown[row-1], true
+}
+
+// showDetails lists every CLIENT LIST field of a client
+func (v *ClientsView) showDetails(c redis.ClientInfo) {
+	keys := make([]string, 0, len(c.Fields))
+	for k := range c.Fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "%s=%s\n", k, c.Fields[k])
+	}
+	v.host.showMessage(b.String())
+}
+
+// killSelected kills the selected client by ID
+func (v *ClientsView) killSelected() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	c, ok := v.selectedClient()
+	if !ok {
+		return
+	}
+
+	text := fmt.Sprintf("Kill client %s (%s)?", c.ID, c.Address)
+	if v.redis.IsOwnConnection(c.ID) {
+		text += "\nThis is one of this app's own connections."
+	}
+	v.host.confirm(text, func() {
+		v.kill(redis.ClientKillFilter{ID: c.ID})
+	})
+}
+
+// showKillDialog kills clients by ID, address, user or type
+func (v *ClientsView) showKillDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "client_kill"
+
+	filter := redis.ClientKillFilter{SkipMe: true}
+	if c, ok := v.selectedClient(); ok {
+		filter.Addr = c.Address
+	}
+	types := []string{"", "normal", "master", "replica", "pubsub"}
+
+	form := v.host.newDialogForm(name, "CLIENT KILL")
+	form.AddInputField("ID", "", 12, tview.InputFieldInteger, func(text string) {
+		filter.ID = strings.TrimSpace(text)
+	})
+	form.AddInputField("Address", filter.Addr, 30, nil, func(text string) {
+		filter.Addr = strings.TrimSpace(text)
+	})
+	form.AddInputField("User", "", 30, nil, func(text string) {
+		filter.User = strings.TrimSpace(text)
+	})
+	form.AddDropDown("Type", types, 0, func(option string, index int) {
+		filter.Type = option
+	})
+	form.AddInputField("Older than (s)", "", 10, tview.InputFieldInteger, func(text string) {
+		filter.MaxAge, _ = strconv.ParseInt(text, 10, 64)
+	})
+	form.AddCheckbox("Skip this connection", true, func(checked bool) {
+		filter.SkipMe = checked
+	})
+	form.AddButton("Kill", func() {
+		v.host.closeDialog(name)
+		v.host.confirm(fmt.Sprintf("Kill every client matching %s?", describeKillFilter(filter)), func() {
+			v.kill(filter)
+		})
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 60, 17)
+}
+
+// kill runs CLIENT KILL and reloads the list
+func (v *ClientsView) kill(filter redis.ClientKillFilter) {
+	n, err := v.redis.KillClients(filter)
+	if err != nil {
+		v.host.showMessage(err.Error())
+		return
+	}
+	logger.Infof("[ClientsView] Killed %d clients matching %s", n, describeKillFilter(filter))
+	v.Refresh()
+	v.host.showMessage(fmt.Sprintf("Killed %d clients", n))
+}
+
+// showPauseDialog suspends clients with CLIENT PAUSE
+func (v *ClientsView) showPauseDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "client_pause"
+
+	timeout := 5000
+	writeOnly := true
+
+	form := v.host.newDialogForm(name, "CLIENT PAUSE")
+	form.AddInputField("Timeout (ms)", strconv.Itoa(timeout), 10, tview.InputFieldInteger, func(text string) {
+		timeout, _ = strconv.Atoi(text)
+	})
+	form.AddCheckbox("Only write commands", true, func(checked bool) {
+		writeOnly = checked
+	})
+	form.AddButton("Pause", func() {
+		v.host.closeDialog(name)
+		mode := "all commands"
+		if writeOnly {
+			mode = "write commands"
+		}
+		d := time.Duration(timeout) * time.Millisecond
+		v.host.confirm(fmt.Sprintf("Pause %s of all clients for %s?", mode, d), func() {
+			if err := v.redis.PauseClients(d, writeOnly); err != nil {
+				v.host.showMessage(err.Error())
+			}
+		})
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 50, 9)
+}
+
+// toggleNoEvict switches CLIENT NO-EVICT for this application's connections
+func (v *ClientsView) toggleNoEvict() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	on := !v.redis.NoEvict()
+	text := "Exclude this app's connections from client eviction (CLIENT NO-EVICT ON)?"
+	if !on {
+		text = "Allow client eviction of this app's connections again (CLIENT NO-EVICT OFF)?"
+	}
+	text += "\nCLIENT NO-EVICT only applies to the connection that sends it, so other clients cannot be changed."
+
+	v.host.confirm(text, func() {
+		if err := v.redis.SetNoEvict(on); err != nil {
+			v.host.showMessage(err.Error())
+		}
+		v.Refresh()
+	})
+}
+
+// matchClientFilter matches a client against "user:", "lib:" and "addr:" terms or free text
+func matchClientFilter(c redis.ClientInfo, filter string) bool {
+	for _, term := range strings.Fields(strings.ToLower(filter)) {
+		field, value, scoped := strings.Cut(term, ":")
+		switch {
+		case scoped && field == "user":
+			if !strings.Contains(strings.ToLower(c.User), value) {
+				return false
+			}
+		case scoped && (field == "lib" || field == "library"):
+			if !strings.Contains(strings.ToLower(clientLibrary(c)), value) {
+				return false
+			}
+		case scoped && (field == "addr" || field == "address"):
+			if !strings.Contains(c.Address, value) && !strings.Contains(c.LocalAddress, value) {
+				return false
+			}
+		default:
+			text := strings.ToLower(strings.Join([]string{c.ID, c.Address, c.Name, c.User, clientLibrary(c), c.LastCommand, c.Flags}, " "))
+			if !strings.Contains(text, term) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// clientLibrary returns lib-name and lib-ver of a client
+func clientLibrary(c redis.ClientInfo) string {
+	if c.LibVersion == "" {
+		return c.LibName
+	}
+	return c.LibName + " " + c.LibVersion
+}
+
+// describeKillFilter formats a CLIENT KILL filter for confirmations
+func describeKillFilter(f redis.ClientKillFilter) string {
+	var parts []string
+	if f.ID != "" {
+		parts = append(parts, "id="+f.ID)
+	}
+	if f.Addr != "" {
+		parts = append(parts, "addr="+f.Addr)
+	}
+	if f.User != "" {
+		parts = append(parts, "user="+f.User)
+	}
+	if f.Type != "" {
+		parts = append(parts, "type="+f.Type)
+	}
+	if f.MaxAge > 0 {
+		parts = append(parts, fmt.Sprintf("age>%ds", f.MaxAge))
+	}
+	if len(parts) == 0 {
+		return "(no filter)"
+	}
+	return strings.Join(parts, " ")
+}
+
+// atoi64 parses an integer, returning 0 on error
+func atoi64(s string) int64 {
+	n, _ := strconv.ParseInt(s, 10, 64)
+	return n
+}
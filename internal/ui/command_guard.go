@@ -0,0 +1,76 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/rivo/tview"
+)
+
+// guardCommand runs a command straight away, or after typed confirmation when the connection's
+// guard flags it as destructive, blocking, O(N) or admin
+func guardCommand(host *viewHost, client *redis.Client, args []string, run, cancel func()) {
+	checkCommands(host, client, [][]string{args}, func(dangers []*redis.Danger) {
+		if dangers[0] == nil {
+			run()
+			return
+		}
+		host.confirmTyped(dangerText(dangers[0]), dangers[0].Confirm, run, cancel)
+	})
+}
+
+// checkCommands passes the guard's verdict on each command to done on the UI thread. Sizing
+// the keys of O(N) commands asks the server, so those checks run in the background while a
+// dialog holds the focus, keeping later input from running first.
+func checkCommands(host *viewHost, client *redis.Client, cmds [][]string, done func([]*redis.Danger)) {
+	check := func() []*redis.Danger {
+		dangers := make([]*redis.Danger, len(cmds))
+		for i, args := range cmds {
+			dangers[i] = client.CheckCommand(args)
+		}
+		return dangers
+	}
+
+	onServer := false
+	for _, args := range cmds {
+		onServer = onServer || client.ChecksOnServer(args)
+	}
+	if !onServer {
+		done(check())
+		return
+	}
+
+	const name = "guard-check"
+	status := tview.NewTextView().
+		SetTextAlign(tview.AlignCenter).
+		SetText("Checking key sizes...")
+	status.SetBorder(true)
+	host.showDialog(name, status, 40, 3)
+
+	go func() {
+		dangers := check()
+		host.queueUpdate(func() {
+			host.closeDialog(name)
+			done(dangers)
+		})
+	}()
+}
+
+// dangerText explains a flagged command and what to type
+func dangerText(d *redis.Danger) string {
+	what := fmt.Sprintf("type [yellow]%s[-]", d.Confirm)
+	if d.Class == redis.DangerDestructive {
+		what = fmt.Sprintf("type the database number ([yellow]%s[-])", d.Confirm)
+	}
+	return fmt.Sprintf("[red]%s[-] is a %s command: %s.\nTo run it, %s.", d.Command, d.Class, tview.Escape(d.Reason), what)
+}
+
+// readOnlyBlocked reports whether the connection is read-only, telling the user the edit action is disabled
+func readOnlyBlocked(host *viewHost, client *redis.Client) bool {
+	if !client.ReadOnly() {
+		return false
+	}
+	host.showMessage("This action is disabled in read-only mode")
+	return true
+}
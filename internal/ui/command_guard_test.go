@@ -0,0 +1,14 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestDangerText tests the confirmation text
+func TestDangerText(t *testing.T) {
+	d := &redis.Danger{Command: "FLUSHDB", Class: redis.DangerDestructive, Reason: "it deletes data in bulk", Confirm: "3"}
+	assert.Equal(t, "[red]FLUSHDB[-] is a destructive command: it deletes data in bulk.\nTo run it, type the database number ([yellow]3[-]).", dangerText(d))
+}
@@ -0,0 +1,255 @@
+package ui
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// commandHistorySize is how many prompt commands are remembered
+	commandHistorySize = 50
+
+	// commandSuggestions is the most entries shown in the autocomplete list
+	commandSuggestions = 10
+)
+
+// promptCommand is a ":" command offered by autocomplete
+type promptCommand struct {
+	name string
+	args func() []string // Argument suggestions, nil when the command takes none
+}
+
+// commandPrompt is the k9s-style ":" command line shown on top of the current view
+type commandPrompt struct {
+	host     *viewHost
+	input    *tview.InputField
+	history  []string // Oldest first
+	commands func() []promptCommand
+	execute  func(command string)
+
+	// args holds each command's argument suggestions, taken once when the prompt opens
+	args map[string][]string
+
+	// navigated is set once an autocomplete entry was picked with the arrow keys
+	navigated bool
+}
+
+// newCommandPrompt creates a prompt that runs commands with execute
+func newCommandPrompt(host *viewHost, commands func() []promptCommand, execute func(command string)) *commandPrompt {
+	p := &commandPrompt{
+		host:     host,
+		commands: commands,
+		execute:  execute,
+	}
+
+	p.input = tview.NewInputField().
+		SetLabel(":").
+		SetFieldWidth(0).
+		SetFieldBackgroundColor(tcell.ColorBlack)
+	p.input.SetBorder(true).
+		SetTitle(" Command (Tab complete, Enter run, Esc cancel) ").
+		SetTitleAlign(tview.AlignLeft)
+
+	p.input.SetChangedFunc(func(text string) {
+		p.navigated = false
+	})
+	p.input.SetAutocompleteFunc(p.suggest)
+	p.input.SetAutocompletedFunc(func(text string, index, source int) bool {
+		switch source {
+		case tview.AutocompletedNavigate:
+			p.navigated = true
+		case tview.AutocompletedEnter:
+			// Enter runs what was typed unless an entry was picked with the arrow keys
+			if !p.navigated && strings.TrimSpace(p.input.GetText()) != "" {
+				text = p.input.GetText()
+			}
+			p.run(text)
+			return true
+		case tview.AutocompletedTab, tview.AutocompletedClick:
+			p.input.SetText(p.completed(text))
+			return true
+		}
+		return false
+	})
+	p.input.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			p.run(p.input.GetText())
+		case tcell.KeyEscape:
+			p.close()
+		}
+	})
+
+	return p
+}
+
+// show opens the prompt with an empty command line, listing recent commands
+func (p *commandPrompt) show() {
+	p.snapshotArgs()
+	p.input.SetText("")
+	p.host.showDialog("command", p.input, 70, 3)
+	p.input.Autocomplete()
+}
+
+// snapshotArgs collects the argument suggestions so typing doesn't query the server or views
+func (p *commandPrompt) snapshotArgs() {
+	p.args = make(map[string][]string)
+	for _, c := range p.commands() {
+		if c.args != nil {
+			p.args[c.name] = c.args()
+		}
+	}
+}
+
+// close hides the prompt
+func (p *commandPrompt) close() {
+	p.host.closeDialog("command")
+}
+
+// run closes the prompt, records the command and executes it
+func (p *commandPrompt) run(command string) {
+	command = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(command), ":"))
+	p.close()
+	if command == "" {
+		return
+	}
+	p.remember(command)
+	p.execute(command)
+}
+
+// remember adds a command to the history, moving repeated commands to the end
+func (p *commandPrompt) remember(command string) {
+	for i, h := range p.history {
+		if h == command {
+			p.history = append(p.history[:i], p.history[i+1:]...)
+			break
+		}
+	}
+	p.history = append(p.history, command)
+	if len(p.history) > commandHistorySize {
+		p.history = p.history[len(p.history)-commandHistorySize:]
+	}
+}
+
+// completed adds a space after a command name that takes arguments so they can be typed next
+func (p *commandPrompt) completed(text string) string {
+	if strings.Contains(text, " ") {
+		return text
+	}
+	for _, c := range p.commands() {
+		if c.name == text && c.args != nil {
+			return text + " "
+		}
+	}
+	return text
+}
+
+// suggest returns autocomplete entries for the text typed so far
+func (p *commandPrompt) suggest(text string) []string {
+	text = strings.TrimPrefix(text, ":")
+
+	// An empty prompt lists the most recent commands
+	if strings.TrimSpace(text) == "" {
+		var recent []string
+		for i := len(p.history) - 1; i >= 0 && len(recent) < commandSuggestions; i-- {
+			recent = append(recent, p.history[i])
+		}
+		return recent
+	}
+
+	name, arg, hasArg := strings.Cut(text, " ")
+	if !hasArg {
+		names := make([]string, 0, len(p.commands()))
+		for _, c := range p.commands() {
+			names = append(names, c.name)
+		}
+		return fuzzyFilter(name, names, commandSuggestions)
+	}
+
+	// Arguments: the command's own suggestions and earlier uses of the same command
+	var candidates []string
+	seen := make(map[string]bool)
+	for i := len(p.history) - 1; i >= 0; i-- {
+		if hName, hArg, ok := strings.Cut(p.history[i], " "); ok && hName == name && !seen[hArg] {
+			seen[hArg] = true
+			candidates = append(candidates, hArg)
+		}
+	}
+	for _, a := range p.args[name] {
+		if !seen[a] {
+			seen[a] = true
+			candidates = append(candidates, a)
+		}
+	}
+
+	matches := fuzzyFilter(strings.TrimSpace(arg), candidates, commandSuggestions)
+	for i, m := range matches {
+		matches[i] = name + " " + m
+	}
+	return matches
+}
+
+// fuzzyFilter returns the candidates containing the pattern's characters in order, best matches first
+func fuzzyFilter(pattern string, candidates []string, limit int) []string {
+	type scored struct {
+		text  string
+		score int
+		index int
+	}
+
+	var matches []scored
+	for i, c := range candidates {
+		if score, ok := fuzzyScore(pattern, c); ok {
+			matches = append(matches, scored{c, score, i})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].score != matches[j].score {
+			return matches[i].score > matches[j].score
+		}
+		return matches[i].index < matches[j].index
+	})
+
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	out := make([]string, len(matches))
+	for i, m := range matches {
+		out[i] = m.text
+	}
+	return out
+}
+
+// fuzzyScore scores a case-insensitive subsequence match, favouring prefixes and consecutive characters
+func fuzzyScore(pattern, candidate string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	c := []rune(strings.ToLower(candidate))
+	if len(p) == 0 {
+		return 0, true
+	}
+
+	score, pi, last := 0, 0, -1
+	for ci := 0; ci < len(c) && pi < len(p); ci++ {
+		if c[ci] != p[pi] {
+			continue
+		}
+		switch {
+		case ci == 0:
+			score += 10
+		case ci == last+1:
+			score += 5
+		default:
+			score -= ci - last - 1
+		}
+		last = ci
+		pi++
+	}
+	if pi < len(p) {
+		return 0, false
+	}
+	// Shorter candidates win ties, so "db" ranks above "debug" for "d"
+	return score*10 - len(c), true
+}
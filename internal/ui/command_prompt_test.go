@@ -0,0 +1,41 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCommandPromptSuggestions tests fuzzy matching of command names and arguments
+func TestCommandPromptSuggestions(t *testing.T) {
+	assert.Equal(t, []string{"cli", "clients"}, fuzzyFilter("cl", []string{"config", "clients", "cli"}, 10))
+	assert.Equal(t, []string{"monitor"}, fuzzyFilter("mntr", []string{"config", "monitor", "keys"}, 10))
+	assert.Equal(t, []string{"db", "debug"}, fuzzyFilter("d", []string{"debug", "db"}, 10))
+	assert.Empty(t, fuzzyFilter("xyz", []string{"keys", "info"}, 10))
+	assert.Len(t, fuzzyFilter("", []string{"a", "b", "c"}, 2), 2)
+
+	calls := 0
+	p := &commandPrompt{
+		commands: func() []promptCommand {
+			return []promptCommand{
+				{name: "keys"},
+				{name: "connect", args: func() []string {
+					calls++
+					return []string{"prod", "staging"}
+				}},
+			}
+		},
+	}
+	p.remember("connect local")
+	p.remember("keys")
+	p.snapshotArgs()
+
+	assert.Equal(t, []string{"keys", "connect local"}, p.suggest(""))
+	assert.Equal(t, []string{"connect local", "connect prod", "connect staging"}, p.suggest("connect "))
+	assert.Equal(t, []string{"connect staging"}, p.suggest("connect stg"))
+	assert.Equal(t, "connect ", p.completed("connect"))
+	assert.Equal(t, "keys", p.completed("keys"))
+
+	// Argument suggestions are taken once per prompt, not on every keystroke
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,316 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// sameServerTarget is the target option that compares two databases on the current server
+const sameServerTarget = "(this server, other DB)"
+
+// CompareView compares the keyspace of the current connection with another instance or database
+type CompareView struct {
+	redis  *redis.Client
+	config *config.Config
+	host   *viewHost
+
+	// Components
+	flex        *tview.Flex
+	diffTable   *tview.Table
+	sourceValue *tview.TextView
+	targetValue *tview.TextView
+
+	// State
+	target      *redis.Client
+	targetLabel string
+	lastOptions redis.CompareOptions
+	diffs       []redis.KeyDiff
+	cancel      context.CancelFunc
+}
+
+// NewCompareView creates a new compare view
+func NewCompareView(redisClient *redis.Client, cfg *config.Config) *CompareView {
+	view := &CompareView{
+		redis:  redisClient,
+		config: cfg,
+	}
+
+	view.setupUI()
+	view.showEmpty()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *CompareView) setupUI() {
+	v.diffTable = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.diffTable.SetBorder(true).
+		SetTitle("Keyspace Differences").
+		SetTitleAlign(tview.AlignLeft)
+
+	v.diffTable.SetSelectedFunc(func(row, col int) {
+		if row > 0 && row <= len(v.diffs) && v.target != nil {
+			go v.showValues(v.target, v.targetLabel, v.diffs[row-1])
+		}
+	})
+
+	v.sourceValue = tview.NewTextView().
+		SetDynamicColors(false).
+		SetScrollable(true).
+		SetWrap(true)
+	v.sourceValue.SetBorder(true).SetTitle("Source")
+
+	v.targetValue = tview.NewTextView().
+		SetDynamicColors(false).
+		SetScrollable(true).
+		SetWrap(true)
+	v.targetValue.SetBorder(true).SetTitle("Target")
+
+	values := tview.NewFlex().SetDirection(tview.FlexColumn).
+		AddItem(v.sourceValue, 0, 1, false).
+		AddItem(v.targetValue, 0, 1, false)
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.diffTable, 0, 1, true).
+		AddItem(values, 0, 1, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'n', 'N':
+			v.showCompareDialog()
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *CompareView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *CompareView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// showEmpty shows the hint displayed before the first comparison
+func (v *CompareView) showEmpty() {
+	v.diffTable.Clear()
+	v.diffTable.SetCell(0, 0, tview.NewTableCell("Press n to compare this keyspace with another instance or database").
+		SetTextColor(tcell.ColorYellow).
+		SetSelectable(false))
+}
+
+// showCompareDialog asks for the target and options of a new comparison
+func (v *CompareView) showCompareDialog() {
+	const name = "compare"
+
+	targets := append([]string{sameServerTarget}, v.config.ProfileNames()...)
+	target := targets[0]
+	targetDB := v.redis.Config().DB + 1
+	opts := redis.CompareOptions{Pattern: "*", BatchSize: 100, CompareValues: true, TTLTolerance: 2 * time.Second}
+
+	form := v.host.newDialogForm(name, "Compare keyspaces")
+	form.AddDropDown("Target", targets, 0, func(option string, index int) {
+		target = option
+	})
+	form.AddInputField("Target DB (this server)", strconv.Itoa(targetDB), 4, tview.InputFieldInteger, func(text string) {
+		targetDB, _ = strconv.Atoi(text)
+	})
+	form.AddInputField("Pattern", "*", 40, nil, func(text string) {
+		opts.Pattern = text
+	})
+	form.AddInputField("TTL tolerance (s)", "2", 6, tview.InputFieldInteger, func(text string) {
+		seconds, _ := strconv.Atoi(text)
+		opts.TTLTolerance = time.Duration(seconds) * time.Second
+	})
+	form.AddCheckbox("Compare values", true, func(checked bool) {
+		opts.CompareValues = checked
+	})
+	form.AddButton("Compare", func() {
+		v.host.closeDialog(name)
+		v.startCompare(target, targetDB, opts)
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 70, 15)
+}
+
+// startCompare connects to the target in the background, then runs the comparison
+func (v *CompareView) startCompare(target string, targetDB int, opts redis.CompareOptions) {
+	var targetCfg *config.RedisConfig
+	var label string
+	if target == sameServerTarget {
+		cfg := v.redis.Config()
+		cfg.DB = targetDB
+		targetCfg = &cfg
+		label = fmt.Sprintf("db%d", targetDB)
+	} else {
+		cfg, err := v.config.Profile(target)
+		if err != nil {
+			v.host.showMessage(err.Error())
+			return
+		}
+		targetCfg = cfg
+		label = target
+	}
+
+	v.diffTable.Clear()
+	v.diffTable.SetTitle(fmt.Sprintf("Keyspace Differences [connecting to %s]", label))
+
+	go func() {
+		client, err := redis.New(targetCfg)
+		v.host.queueUpdate(func() {
+			if err != nil {
+				v.diffTable.SetTitle("Keyspace Differences")
+				v.host.showMessage(fmt.Sprintf("Compare failed: %v", err))
+				return
+			}
+
+			// Stop the comparison against the old target before closing it
+			if v.cancel != nil {
+				v.cancel()
+				v.cancel = nil
+			}
+			if v.target != nil {
+				v.target.Close()
+			}
+			v.target = client
+			v.targetLabel = label
+			v.lastOptions = opts
+			v.runCompare()
+		})
+	}()
+}
+
+// runCompare starts a comparison against the current target in the background, cancelling
+// any comparison in progress; it runs on the UI thread
+func (v *CompareView) runCompare() {
+	if v.target == nil {
+		return
+	}
+	if v.cancel != nil {
+		v.cancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.cancel = cancel
+
+	target, label, opts := v.target, v.targetLabel, v.lastOptions
+	opts.Progress = func(p redis.CompareProgress) {
+		v.host.queueUpdate(func() {
+			if ctx.Err() == nil {
+				v.diffTable.SetTitle(fmt.Sprintf("Keyspace Differences vs %s [running: %s]", label, p))
+			}
+		})
+	}
+
+	logger.Infof("[CompareView] Comparing %s with %s", opts.Pattern, label)
+	go func() {
+		diffs, err := v.redis.Compare(ctx, target, opts)
+		v.host.queueUpdate(func() {
+			if ctx.Err() != nil {
+				return
+			}
+			cancel()
+			v.cancel = nil
+			if err != nil {
+				v.host.showMessage(fmt.Sprintf("Compare failed: %v", err))
+			}
+			v.diffs = diffs
+			v.renderDiffs()
+		})
+	}()
+}
+
+// renderDiffs fills the table with the current differences
+func (v *CompareView) renderDiffs() {
+	v.diffTable.Clear()
+
+	headers := []string{"Key", "Difference", "Source", "Target"}
+	for i, header := range headers {
+		v.diffTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	status := "identical"
+	if len(v.diffs) > 0 {
+		status = fmt.Sprintf("%d differences", len(v.diffs))
+	}
+	v.diffTable.SetTitle(fmt.Sprintf("Keyspace Differences vs %s [%s] - Enter: compare values, n: new, r: re-run", v.targetLabel, status))
+
+	for i, d := range v.diffs {
+		row := i + 1
+		color := tcell.ColorWhite
+		switch d.Kind {
+		case redis.DiffMissingOnSource, redis.DiffMissingOnTarget:
+			color = tcell.ColorRed
+		case redis.DiffType, redis.DiffValue:
+			color = tcell.ColorOrange
+		}
+
+		v.diffTable.SetCell(row, 0, tview.NewTableCell(tview.Escape(d.Key)))
+		v.diffTable.SetCell(row, 1, tview.NewTableCell(string(d.Kind)).SetTextColor(color))
+		v.diffTable.SetCell(row, 2, tview.NewTableCell(describeKeyState(d.SourceType, d.SourceTTL)))
+		v.diffTable.SetCell(row, 3, tview.NewTableCell(describeKeyState(d.TargetType, d.TargetTTL)))
+	}
+
+	if len(v.diffs) > 0 {
+		v.diffTable.Select(1, 0)
+	}
+}
+
+// showValues loads the value of a diverging key from both sides
+func (v *CompareView) showValues(targetClient *redis.Client, label string, d redis.KeyDiff) {
+	source, err := v.redis.CanonicalValue(d.Key)
+	if err != nil {
+		source = fmt.Sprintf("Error: %v", err)
+	}
+	target, err := targetClient.CanonicalValue(d.Key)
+	if err != nil {
+		target = fmt.Sprintf("Error: %v", err)
+	}
+
+	v.host.queueUpdate(func() {
+		v.sourceValue.SetTitle(fmt.Sprintf("Source: %s (%s)", tview.Escape(d.Key), describeKeyState(d.SourceType, d.SourceTTL)))
+		v.sourceValue.SetText(source).ScrollToBeginning()
+		v.targetValue.SetTitle(fmt.Sprintf("Target %s: %s (%s)", tview.Escape(label), tview.Escape(d.Key), describeKeyState(d.TargetType, d.TargetTTL)))
+		v.targetValue.SetText(target).ScrollToBeginning()
+	})
+}
+
+// describeKeyState formats a key's type and TTL for the diff table
+func describeKeyState(keyType string, ttl time.Duration) string {
+	if keyType == "" || keyType == "none" {
+		return "-"
+	}
+	if ttl < 0 {
+		return keyType + ", persistent"
+	}
+	return fmt.Sprintf("%s, ttl %s", keyType, ttl.Round(time.Second))
+}
+
+// Refresh re-runs the last comparison
+func (v *CompareView) Refresh() {
+	v.runCompare()
+}
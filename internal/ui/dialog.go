@@ -0,0 +1,205 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// viewHost gives views access to app-level facilities such as modal dialogs
+type viewHost struct {
+	app      *tview.Application
+	pages    *tview.Pages
+	testMode bool
+	dialogs  []string
+
+	// onSwitchView is set by the App so views can switch to another view
+	onSwitchView func(view ViewType)
+}
+
+// newViewHost creates a host bound to the application's root pages
+func newViewHost(app *tview.Application, pages *tview.Pages) *viewHost {
+	return &viewHost{
+		app:   app,
+		pages: pages,
+	}
+}
+
+// hasDialog reports whether a dialog is currently shown
+func (h *viewHost) hasDialog() bool {
+	return h != nil && len(h.dialogs) > 0
+}
+
+// switchView asks the App to show another view
+func (h *viewHost) switchView(view ViewType) {
+	if h != nil && h.onSwitchView != nil {
+		h.onSwitchView(view)
+	}
+}
+
+// setFocus moves keyboard focus to a primitive
+func (h *viewHost) setFocus(p tview.Primitive) {
+	if h == nil || h.app == nil || h.testMode {
+		return
+	}
+	h.app.SetFocus(p)
+}
+
+// showDialog shows a primitive centered on top of the main layout
+func (h *viewHost) showDialog(name string, p tview.Primitive, width, height int) {
+	if h == nil || h.pages == nil {
+		return
+	}
+
+	h.closeDialog(name)
+	h.pages.AddPage(name, centered(p, width, height), true, true)
+	h.dialogs = append(h.dialogs, name)
+
+	if !h.testMode && h.app != nil {
+		h.app.SetFocus(p)
+	}
+}
+
+// closeDialog removes a dialog and returns focus to whatever is below it
+func (h *viewHost) closeDialog(name string) {
+	if h == nil || h.pages == nil {
+		return
+	}
+
+	h.pages.RemovePage(name)
+	for i, d := range h.dialogs {
+		if d == name {
+			h.dialogs = append(h.dialogs[:i], h.dialogs[i+1:]...)
+			break
+		}
+	}
+}
+
+// showMessage shows a simple modal with an OK button
+func (h *viewHost) showMessage(text string) {
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"OK"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			h.closeDialog("message")
+		})
+	h.showModal("message", modal)
+}
+
+// confirm asks a yes/no question and calls onConfirm when the user accepts
+func (h *viewHost) confirm(text string, onConfirm func()) {
+	modal := tview.NewModal().
+		SetText(text).
+		AddButtons([]string{"Yes", "No"}).
+		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+			h.closeDialog("confirm")
+			if buttonLabel == "Yes" && onConfirm != nil {
+				onConfirm()
+			}
+		})
+	h.showModal("confirm", modal)
+}
+
+// confirmTyped asks the user to type expected before onConfirm runs; onCancel runs when the dialog is dismissed
+func (h *viewHost) confirmTyped(text, expected string, onConfirm, onCancel func()) {
+	const name = "confirm-typed"
+	form := h.newDialogForm(name, "Confirm")
+	form.AddTextView("", text, 0, 4, true, false)
+	form.AddInputField(fmt.Sprintf("Type %s", expected), "", 0, nil, nil)
+	input := form.GetFormItem(1).(*tview.InputField)
+
+	cancel := func() {
+		h.closeDialog(name)
+		if onCancel != nil {
+			onCancel()
+		}
+	}
+	form.AddButton("Run", func() {
+		if strings.TrimSpace(input.GetText()) != expected {
+			input.SetText("")
+			h.setFocus(input)
+			return
+		}
+		h.closeDialog(name)
+		onConfirm()
+	})
+	form.AddButton("Cancel", cancel)
+	form.SetCancelFunc(cancel)
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			cancel()
+			return nil
+		}
+		return event
+	})
+	h.showDialog(name, form, 76, 12)
+}
+
+// showModal adds a full-screen modal page; tview.Modal centers itself
+func (h *viewHost) showModal(name string, modal *tview.Modal) {
+	if h == nil || h.pages == nil {
+		return
+	}
+
+	h.closeDialog(name)
+	h.pages.AddPage(name, modal, true, true)
+	h.dialogs = append(h.dialogs, name)
+
+	if !h.testMode && h.app != nil {
+		h.app.SetFocus(modal)
+	}
+}
+
+// queueUpdate runs fn on the UI goroutine and redraws; safe to call from background goroutines only
+func (h *viewHost) queueUpdate(fn func()) {
+	if h == nil || h.app == nil || h.testMode {
+		fn()
+		return
+	}
+	h.app.QueueUpdateDraw(fn)
+}
+
+// newDialogForm creates a bordered form that closes itself on ESC
+func (h *viewHost) newDialogForm(name, title string) *tview.Form {
+	form := tview.NewForm()
+	form.SetBorder(true).
+		SetTitle(" " + title + " ").
+		SetTitleAlign(tview.AlignLeft)
+	form.SetCancelFunc(func() {
+		h.closeDialog(name)
+	})
+	form.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyEscape {
+			h.closeDialog(name)
+			return nil
+		}
+		return event
+	})
+	return form
+}
+
+// centered wraps a primitive so it is drawn in the middle of the screen
+func centered(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
+// expandPath expands a leading ~ to the user's home directory
+func expandPath(path string) string {
+	path = strings.TrimSpace(path)
+	if path == "~" || strings.HasPrefix(path, "~/") {
+		if home, err := os.UserHomeDir(); err == nil {
+			return filepath.Join(home, strings.TrimPrefix(path, "~"))
+		}
+	}
+	return path
+}
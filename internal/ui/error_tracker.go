@@ -0,0 +1,81 @@
+package ui
+
+import (
+	"sort"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+)
+
+// newErrorHighlight is how long an error type that just appeared stays highlighted
+const newErrorHighlight = time.Minute
+
+// errorRate is the state of one error type after a sample
+type errorRate struct {
+	errType   string
+	count     int64
+	perSec    float64
+	firstSeen time.Time
+	isNew     bool
+}
+
+// errorTracker turns INFO errorstats samples into per-interval rates and spots new error types
+type errorTracker struct {
+	prev      map[string]int64
+	prevTime  time.Time
+	firstSeen map[string]time.Time
+	baseline  map[string]bool // Types present in the first sample
+	primed    bool
+}
+
+// newErrorTracker creates an empty tracker
+func newErrorTracker() *errorTracker {
+	return &errorTracker{
+		firstSeen: make(map[string]time.Time),
+		baseline:  make(map[string]bool),
+	}
+}
+
+// Update records a sample and returns the error types, busiest first
+func (t *errorTracker) Update(stats []redis.ErrorStat, at time.Time) []errorRate {
+	elapsed := at.Sub(t.prevTime).Seconds()
+
+	rates := make([]errorRate, 0, len(stats))
+	current := make(map[string]int64, len(stats))
+	for _, stat := range stats {
+		current[stat.Type] = stat.Count
+
+		// Types already present in the first sample are not new; first-seen times survive CONFIG RESETSTAT
+		first, seen := t.firstSeen[stat.Type]
+		if !seen {
+			first = at
+			t.firstSeen[stat.Type] = at
+			t.baseline[stat.Type] = !t.primed
+		}
+
+		rate := errorRate{
+			errType:   stat.Type,
+			count:     stat.Count,
+			firstSeen: first,
+			isNew:     !t.baseline[stat.Type] && at.Sub(first) < newErrorHighlight,
+		}
+		if old, ok := t.prev[stat.Type]; t.primed && elapsed > 0 {
+			delta := stat.Count - old
+			if !ok || delta < 0 {
+				delta = stat.Count
+			}
+			rate.perSec = float64(delta) / elapsed
+		}
+		rates = append(rates, rate)
+	}
+
+	t.prev, t.prevTime, t.primed = current, at, true
+
+	sort.SliceStable(rates, func(i, j int) bool {
+		if rates[i].perSec != rates[j].perSec {
+			return rates[i].perSec > rates[j].perSec
+		}
+		return rates[i].count > rates[j].count
+	})
+	return rates
+}
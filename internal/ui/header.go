@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
@@ -55,8 +56,7 @@ func (a *App) createHeader() *tview.Flex {
 }
 
 // formatHeaderText formats the header text based on current metrics
-func (a *App) formatHeaderText() string {
-	info, err := a.redis.Info()
+func (a *App) formatHeaderText(info map[string]interface{}, err error) string {
 	if err != nil {
 		return "[red]Disconnected from Redis"
 	}
@@ -106,7 +106,7 @@ func (a *App) formatHeaderText() string {
 	uptime := utils.FormatUptime(uptimeSeconds)
 
 	return fmt.Sprintf(" redis-dashboard │ DB: db%d │ Keys: %d │ Version: %s │ State: %s │ Eviction: %s │ Memory: %s │ Clients: %d │ Uptime: %s │ [dim]1-6: Views │ ?: Help[white] ",
-		a.config.Redis.DB,
+		a.conn.DB,
 		keyCount,
 		redisVersion,
 		redisState,
@@ -116,18 +116,54 @@ func (a *App) formatHeaderText() string {
 		uptime)
 }
 
-// updateHeaderContent updates the header content with Redis metrics
+// updateHeaderContent updates the header content with Redis metrics and the firing alerts
 func (a *App) updateHeaderContent(header *tview.TextView) {
-	header.SetText(a.formatHeaderText())
+	info, err := a.redis.Info()
+	header.SetText(a.formatReadOnlyBadge() + a.formatAlertBanner() + a.formatHeaderText(info, err))
+}
+
+// formatReadOnlyBadge returns a badge when the connection refuses writes, or nothing
+func (a *App) formatReadOnlyBadge() string {
+	if !a.redis.ReadOnly() {
+		return ""
+	}
+	return "[black:yellow:b] READ-ONLY [-:-:-] "
+}
+
+// checkAlerts evaluates the alert rules against a sample of the Monitoring view's loop
+func (a *App) checkAlerts(info map[string]interface{}) {
+	if a.alerts == nil {
+		return
+	}
+	if events := a.alerts.Evaluate(info, time.Now()); len(events) > 0 && a.alertsView != nil {
+		a.host.queueUpdate(a.alertsView.Refresh)
+	}
+}
+
+// formatAlertBanner returns a banner naming the firing alerts, or nothing
+func (a *App) formatAlertBanner() string {
+	if a.alerts == nil {
+		return ""
+	}
+	firing := a.alerts.Firing()
+	if len(firing) == 0 {
+		return ""
+	}
+
+	names := make([]string, len(firing))
+	for i, st := range firing {
+		names[i] = tview.Escape(st.Name)
+	}
+	return fmt.Sprintf("[white:red:b] ⚠ %d ALERT(S): %s (:alerts) [-:-:-] ", len(firing), strings.Join(names, ", "))
 }
 
 // updateHeaderStatus updates the header status line with current metrics
 func (a *App) updateHeaderStatus(status *tview.TextView) {
 	text := fmt.Sprintf(
 		"[white]ⓘ redis://%s:%d/%d  ∞ %dms  ↑%s/↓%s  ⚡ %d ops  ⚪ %d clients",
-		a.config.Redis.Host,
-		a.config.Redis.Port,
-		a.config.Redis.DB,
+		a.conn.Host,
+		a.conn.Port,
+		a.conn.DB,
 		a.metrics.Latency,
 		humanize.Bytes(uint64(a.metrics.MemoryUsed)),
 		humanize.Bytes(uint64(a.metrics.MemoryPeak)),
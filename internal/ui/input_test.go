@@ -25,7 +25,7 @@ func TestInputHandlingAfterViewSwitch(t *testing.T) {
 		},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.testMode = true // Enable test mode to avoid UI operations
 	app.setupUI()
 	app.redis = newTestRedisClient()
@@ -95,7 +95,7 @@ func TestViewInputCaptureChain(t *testing.T) {
 		UI:    config.UIConfig{MaxKeys: 100},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.testMode = true
 	app.setupUI()
 	app.redis = newTestRedisClient()
@@ -141,7 +141,7 @@ func TestGlobalKeyHandling(t *testing.T) {
 		UI:    config.UIConfig{MaxKeys: 100},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.testMode = true
 	app.setupUI()
 	app.redis = newTestRedisClient()
@@ -193,7 +193,7 @@ func TestFocusManagement(t *testing.T) {
 		UI:    config.UIConfig{MaxKeys: 100},
 	}
 
-	app := NewApp(cfg)
+	app := NewApp(cfg, cfg.Redis, false)
 	app.testMode = true
 	app.setupUI()
 	app.redis = newTestRedisClient()
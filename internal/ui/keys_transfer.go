@@ -0,0 +1,359 @@
+package ui
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/rivo/tview"
+)
+
+// Export scopes offered in the export dialog
+const (
+	exportScopeSelected  = "Selected key"
+	exportScopeFiltered  = "Filtered keys"
+	exportScopeNamespace = "Namespace of selected key"
+)
+
+// showExportDialog opens the export form for the selection, filter or namespace
+func (v *KeysView) showExportDialog() {
+	const name = "export"
+
+	scopes := []string{exportScopeSelected, exportScopeFiltered, exportScopeNamespace}
+	formats := exportFormatNames()
+	scope := scopes[0]
+	format := formats[0]
+	path := defaultExportPath(redis.ExportFormat(format))
+
+	form := v.host.newDialogForm(name, "Export keys")
+	form.AddDropDown("Scope", scopes, 0, func(option string, index int) {
+		scope = option
+	})
+	form.AddDropDown("Format", formats, 0, func(option string, index int) {
+		// Keep the extension in sync with the chosen format when the default name is in use
+		if strings.HasPrefix(path, "export-") {
+			path = defaultExportPath(redis.ExportFormat(option))
+			if field, ok := form.GetFormItemByLabel("File").(*tview.InputField); ok {
+				field.SetText(path)
+			}
+		}
+		format = option
+	})
+	form.AddInputField("File", path, 50, nil, func(text string) {
+		path = text
+	})
+	form.AddButton("Export", func() {
+		v.host.closeDialog(name)
+		target, err := v.resolveExportScope(scope)
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Export failed: %v", err))
+			return
+		}
+		go v.exportKeys(target, redis.ExportFormat(format), expandPath(path))
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 70, 11)
+}
+
+// showImportDialog opens the import form
+func (v *KeysView) showImportDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "import"
+
+	formats := exportFormatNames()
+	var policies []string
+	for _, p := range redis.ConflictPolicies {
+		policies = append(policies, string(p))
+	}
+	format := formats[0]
+	policy := policies[0]
+	path := ""
+
+	form := v.host.newDialogForm(name, "Import keys")
+	form.AddInputField("File", "", 50, nil, func(text string) {
+		path = text
+	})
+	form.AddDropDown("Format", formats, 0, func(option string, index int) {
+		format = option
+	})
+	form.AddDropDown("On conflict", policies, 0, func(option string, index int) {
+		policy = option
+	})
+	form.AddButton("Import", func() {
+		v.host.closeDialog(name)
+		go v.importKeys(expandPath(path), redis.ExportFormat(format), redis.ConflictPolicy(policy))
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 70, 11)
+}
+
+// exportKeys scans the target's pattern if it has one and writes the export file
+func (v *KeysView) exportKeys(target exportTarget, format redis.ExportFormat, path string) {
+	keys, err := v.scanExportKeys(target)
+	if err != nil {
+		v.host.queueUpdate(func() {
+			v.host.showMessage(fmt.Sprintf("Export failed: %v", err))
+		})
+		return
+	}
+	if len(keys) == 0 {
+		v.host.queueUpdate(func() {
+			v.host.showMessage("Nothing to export")
+		})
+		return
+	}
+
+	logger.Infof("[KeysView] Exporting %d keys as %s to %s", len(keys), format, path)
+
+	file, err := os.Create(path)
+	if err != nil {
+		v.host.queueUpdate(func() {
+			v.host.showMessage(fmt.Sprintf("Export failed: %v", err))
+		})
+		return
+	}
+	defer file.Close()
+
+	result, err := v.redis.ExportKeys(file, keys, format)
+	v.host.queueUpdate(func() {
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Export failed: %v", err))
+			return
+		}
+		v.host.showMessage(fmt.Sprintf("Exported to %s\n%s", path, result))
+	})
+}
+
+// importKeys reads an export file back into Redis and reloads the key list
+func (v *KeysView) importKeys(path string, format redis.ExportFormat, policy redis.ConflictPolicy) {
+	logger.Infof("[KeysView] Importing %s from %s (conflict policy: %s)", format, path, policy)
+
+	file, err := os.Open(path)
+	if err != nil {
+		v.host.queueUpdate(func() {
+			v.host.showMessage(fmt.Sprintf("Import failed: %v", err))
+		})
+		return
+	}
+	defer file.Close()
+
+	result, err := v.redis.ImportKeys(file, format, policy)
+	v.host.queueUpdate(func() {
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Import failed: %v", err))
+			return
+		}
+		v.host.showMessage(fmt.Sprintf("Imported from %s\n%s", path, result))
+	})
+
+	v.loadKeys()
+}
+
+// exportTarget is what an export covers: listed keys, or every key matching a SCAN pattern
+type exportTarget struct {
+	keys    []string
+	pattern string
+}
+
+// resolveExportScope turns an export scope into keys or a pattern; it reads the view's
+// selection and key list, so it runs on the UI thread before the export starts
+func (v *KeysView) resolveExportScope(scope string) (exportTarget, error) {
+	switch scope {
+	case exportScopeSelected:
+		if v.selectedKey == "" {
+			return exportTarget{}, fmt.Errorf("no key selected")
+		}
+		return exportTarget{keys: []string{v.selectedKey}}, nil
+	case exportScopeFiltered:
+		var keys []string
+		for _, key := range v.getDisplayKeys() {
+			keys = append(keys, key.Name)
+		}
+		return exportTarget{keys: keys}, nil
+	case exportScopeNamespace:
+		if v.selectedKey == "" {
+			return exportTarget{}, fmt.Errorf("no key selected")
+		}
+		return exportTarget{pattern: namespacePattern(v.selectedKey)}, nil
+	default:
+		return exportTarget{}, fmt.Errorf("unknown scope: %s", scope)
+	}
+}
+
+// scanExportKeys returns the target's keys, scanning the whole namespace for a pattern;
+// SCAN may return a key more than once, so duplicates are dropped
+func (v *KeysView) scanExportKeys(target exportTarget) ([]string, error) {
+	if target.pattern == "" {
+		return target.keys, nil
+	}
+
+	var keys []string
+	seen := make(map[string]bool)
+	err := v.redis.ScanKeys(context.Background(), target.pattern, "", func(batch []string) error {
+		for _, key := range batch {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+		return nil
+	})
+	return keys, err
+}
+
+// namespacePattern returns a SCAN pattern for the namespace of a key, e.g. user:* for user:42;
+// glob characters in the prefix are escaped so they match only themselves
+func namespacePattern(key string) string {
+	if i := strings.LastIndex(key, ":"); i >= 0 {
+		return globEscape(key[:i+1]) + "*"
+	}
+	return globEscape(key)
+}
+
+// exportFormatNames returns the export formats as dropdown options
+func exportFormatNames() []string {
+	var names []string
+	for _, f := range redis.ExportFormats {
+		names = append(names, string(f))
+	}
+	return names
+}
+
+// defaultExportPath returns a timestamped file name for the format
+func defaultExportPath(format redis.ExportFormat) string {
+	ext := string(format)
+	if format == redis.FormatJSON {
+		ext = "jsonl"
+	}
+	return fmt.Sprintf("export-%s.%s", time.Now().Format("20060102-150405"), ext)
+}
+
+// showMigrateDialog opens the form for copying keys to another connection profile
+func (v *KeysView) showMigrateDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "migrate"
+
+	profiles := v.config.ProfileNames()
+	if len(profiles) == 0 {
+		v.host.showMessage("No connection profiles configured.\nAdd a \"profiles\" section to the config file to migrate keys.")
+		return
+	}
+
+	pattern := "*"
+	if v.selectedKey != "" {
+		pattern = namespacePattern(v.selectedKey)
+	}
+	opts := redis.MigrateOptions{Pattern: pattern, BatchSize: 100}
+	target := profiles[0]
+
+	form := v.host.newDialogForm(name, "Migrate keys")
+	form.AddDropDown("Target profile", profiles, 0, func(option string, index int) {
+		target = option
+	})
+	form.AddInputField("Pattern", pattern, 40, nil, func(text string) {
+		opts.Pattern = text
+	})
+	form.AddInputField("Batch size", "100", 8, tview.InputFieldInteger, func(text string) {
+		opts.BatchSize, _ = strconv.Atoi(text)
+	})
+	form.AddInputField("Rate limit (keys/s, 0=off)", "0", 8, tview.InputFieldInteger, func(text string) {
+		opts.RateLimit, _ = strconv.Atoi(text)
+	})
+	form.AddCheckbox("Replace existing", false, func(checked bool) {
+		opts.Replace = checked
+	})
+	form.AddInputField("Checkpoint file", "", 40, nil, func(text string) {
+		opts.Checkpoint = expandPath(text)
+	})
+	form.AddButton("Start", func() {
+		v.host.closeDialog(name)
+		v.runMigration(target, opts)
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 70, 17)
+}
+
+// runMigration runs a migration in the background with a cancellable progress dialog
+func (v *KeysView) runMigration(profileName string, opts redis.MigrateOptions) {
+	const name = "migrate_progress"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	finished := false
+
+	progressModal := tview.NewModal().
+		SetText(fmt.Sprintf("Connecting to %s...", profileName)).
+		AddButtons([]string{"Cancel"})
+	progressModal.SetDoneFunc(func(buttonIndex int, buttonLabel string) {
+		cancel()
+		if finished {
+			v.host.closeDialog(name)
+		}
+	})
+	v.host.showModal(name, progressModal)
+
+	finish := func(text string) {
+		v.host.queueUpdate(func() {
+			finished = true
+			progressModal.SetText(text)
+			progressModal.ClearButtons().AddButtons([]string{"Close"})
+		})
+	}
+
+	go func() {
+		defer cancel()
+
+		targetCfg, err := v.config.Profile(profileName)
+		if err != nil {
+			finish(fmt.Sprintf("Migration failed: %v", err))
+			return
+		}
+		target, err := redis.New(targetCfg)
+		if err != nil {
+			finish(fmt.Sprintf("Migration failed: %v", err))
+			return
+		}
+		defer target.Close()
+
+		opts.TargetName = profileName
+		opts.Progress = func(p redis.MigrateProgress) {
+			v.host.queueUpdate(func() {
+				progressModal.SetText(fmt.Sprintf("Migrating %s to %s\n%s", p.Pattern, profileName, p))
+			})
+		}
+
+		logger.Infof("[KeysView] Migrating %s to profile %s", opts.Pattern, profileName)
+		progress, err := v.redis.Migrate(ctx, target, opts)
+		switch {
+		case errors.Is(err, context.Canceled):
+			text := fmt.Sprintf("Migration cancelled\n%s", progress)
+			if opts.Checkpoint != "" {
+				text += "\nStart again with the same checkpoint file to resume."
+			}
+			finish(text)
+		case err != nil:
+			finish(fmt.Sprintf("Migration failed: %v", err))
+		default:
+			finish(fmt.Sprintf("Migration to %s complete\n%s", profileName, progress))
+		}
+	}()
+}
@@ -0,0 +1,17 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestNamespacePattern tests the SCAN pattern of a key's namespace
+func TestNamespacePattern(t *testing.T) {
+	assert.Equal(t, "user:*", namespacePattern("user:42"))
+	assert.Equal(t, "app:session:*", namespacePattern("app:session:abc"))
+	assert.Equal(t, "plain", namespacePattern("plain"))
+	assert.Equal(t, `cache\[v2\]:*`, namespacePattern("cache[v2]:item"))
+	assert.Equal(t, `a\*b\?c\\:*`, namespacePattern(`a*b?c\:x`))
+	assert.Equal(t, `odd\*`, namespacePattern("odd*"))
+}
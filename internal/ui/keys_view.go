@@ -2,8 +2,10 @@ package ui
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/config"
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
@@ -17,6 +19,7 @@ import (
 type KeysView struct {
 	redis  *redis.Client
 	config *config.Config
+	host   *viewHost
 
 	// Components
 	flex          *tview.Flex
@@ -192,6 +195,22 @@ func (v *KeysView) setupUI() {
 					// Reload/refresh keys
 					go v.loadKeys()
 					return nil
+				case 'x':
+					logger.Debug("[KeysView] 'x' key pressed, opening export dialog")
+					v.showExportDialog()
+					return nil
+				case 'i':
+					logger.Debug("[KeysView] 'i' key pressed, opening import dialog")
+					v.showImportDialog()
+					return nil
+				case 'm':
+					logger.Debug("[KeysView] 'm' key pressed, opening migration dialog")
+					v.showMigrateDialog()
+					return nil
+				case 'D':
+					logger.Debug("[KeysView] 'D' key pressed, switching to compare view")
+					v.host.switchView(CompareViewType)
+					return nil
 				// Let all other runes pass through to global handler (numbers, ?, etc.)
 				default:
 					logger.Tracef("[KeysView] Rune '%c' passed through to global handler", event.Rune())
@@ -460,6 +479,28 @@ func (v *KeysView) showKeyDetails(key string) {
 	v.keyDetail.SetText(details)
 }
 
+// KeyPrefixes suggests filters from the loaded keys: each distinct first segment followed by :*
+func (v *KeysView) KeyPrefixes() []string {
+	seen := make(map[string]bool)
+	var prefixes []string
+	for _, key := range v.keys {
+		if i := strings.Index(key.Name, ":"); i > 0 {
+			prefix := key.Name[:i+1] + "*"
+			if !seen[prefix] {
+				seen[prefix] = true
+				prefixes = append(prefixes, prefix)
+			}
+		}
+	}
+	sort.Strings(prefixes)
+	return prefixes
+}
+
+// SetFilter sets the filter text, as if typed into the filter input
+func (v *KeysView) SetFilter(pattern string) {
+	v.filter.SetText(pattern) // The changed handler applies the filter
+}
+
 // applyFilter filters the keys based on the given pattern
 func (v *KeysView) applyFilter(pattern string) {
 	v.filterText = pattern
@@ -469,9 +510,16 @@ func (v *KeysView) applyFilter(pattern string) {
 		return
 	}
 
+	// Patterns with glob characters match like SCAN MATCH, anything else is a substring search
+	isGlob := strings.ContainsAny(pattern, "*?[")
+
 	filtered := make([]*redis.KeyInfo, 0)
 	for _, key := range v.keys {
-		if strings.Contains(strings.ToLower(key.Name), strings.ToLower(pattern)) {
+		if isGlob {
+			if redis.GlobMatch(pattern, key.Name) {
+				filtered = append(filtered, key)
+			}
+		} else if strings.Contains(strings.ToLower(key.Name), strings.ToLower(pattern)) {
 			filtered = append(filtered, key)
 		}
 	}
@@ -489,11 +537,26 @@ func (v *KeysView) executeCommand(command string) {
 	v.commandInput.SetText("")
 
 	// Parse command and arguments
-	parts := strings.Fields(command)
+	parts, err := cmdline.Split(command)
+	if err != nil {
+		v.commandOutput.SetText(fmt.Sprintf("[red]Error:[white] %v", err))
+		return
+	}
 	if len(parts) == 0 {
 		return
 	}
 
+	guardCommand(v.host, v.redis, parts, func() {
+		v.host.setFocus(v.commandInput)
+		v.runCommand(parts)
+	}, func() {
+		v.host.setFocus(v.commandInput)
+		v.commandOutput.SetText("[yellow]Cancelled[white]")
+	})
+}
+
+// runCommand executes a parsed command and reloads the keys it may have changed
+func (v *KeysView) runCommand(parts []string) {
 	cmd := parts[0]
 	args := make([]interface{}, len(parts)-1)
 	for i, arg := range parts[1:] {
@@ -641,6 +704,11 @@ func (v *KeysView) SetFocusCallback(callback func(component tview.Primitive)) {
 	v.onFocusChange = callback
 }
 
+// SetHost sets the host used to show dialogs
+func (v *KeysView) SetHost(host *viewHost) {
+	v.host = host
+}
+
 // GetCurrentFocus returns the currently focused component
 func (v *KeysView) GetCurrentFocus() tview.Primitive {
 	switch v.focusIndex {
@@ -0,0 +1,299 @@
+package ui
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// defaultLatencyThreshold is suggested when latency monitoring is disabled
+const defaultLatencyThreshold = "100"
+
+// LatencyView shows latency monitor events, their history and the doctor report
+type LatencyView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex        *tview.Flex
+	eventsTable *tview.Table
+	bottom      *tview.Pages
+	chart       *tview.TextView
+	doctor      *tview.TextView
+
+	// State
+	events    []redis.LatencyEvent
+	rowOffset int // Table row of the first event
+	selected  string
+	threshold string
+}
+
+// NewLatencyView creates a new latency view
+func NewLatencyView(redisClient *redis.Client) *LatencyView {
+	view := &LatencyView{
+		redis: redisClient,
+	}
+
+	view.setupUI()
+	view.Refresh()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *LatencyView) setupUI() {
+	v.eventsTable = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.eventsTable.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	v.eventsTable.SetSelectionChangedFunc(func(row, col int) {
+		if i := row - v.rowOffset; i >= 0 && i < len(v.events) {
+			v.selected = v.events[i].Name
+			v.loadHistory()
+		}
+	})
+
+	v.chart = tview.NewTextView().
+		SetDynamicColors(false).
+		SetWrap(false)
+	v.chart.SetBorder(true).
+		SetTitle("History").
+		SetTitleAlign(tview.AlignLeft)
+
+	v.doctor = tview.NewTextView().
+		SetScrollable(true).
+		SetWrap(true)
+	v.doctor.SetBorder(true).
+		SetTitle("LATENCY DOCTOR").
+		SetTitleAlign(tview.AlignLeft)
+
+	v.bottom = tview.NewPages().
+		AddPage("chart", v.chart, true, true).
+		AddPage("doctor", v.doctor, true, false)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]d[white] doctor/history  [yellow]x[white] reset selected event  [yellow]X[white] reset all  [yellow]t[white] latency-monitor-threshold  [yellow]r[white] refresh")
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.eventsTable, 0, 1, true).
+		AddItem(v.bottom, 0, 2, false).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'd', 'D':
+			v.toggleDoctor()
+			return nil
+		case 'x':
+			if v.selected != "" && !readOnlyBlocked(v.host, v.redis) {
+				v.host.confirm(fmt.Sprintf("Reset latency history of %q?", v.selected), func() {
+					v.reset(v.selected)
+				})
+			}
+			return nil
+		case 'X':
+			if !readOnlyBlocked(v.host, v.redis) {
+				v.host.confirm("Reset the latency history of all events?", func() {
+					v.reset()
+				})
+			}
+			return nil
+		case 't', 'T':
+			v.showThresholdDialog()
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *LatencyView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *LatencyView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// Refresh reloads the events, the selected event's history and the doctor report
+func (v *LatencyView) Refresh() {
+	v.threshold, _ = v.redis.GetConfigValue("latency-monitor-threshold")
+
+	events, err := v.redis.GetLatencyLatest()
+	v.events = events
+	v.renderEvents(err)
+
+	if name, _ := v.bottom.GetFrontPage(); name == "doctor" {
+		v.loadDoctor()
+	}
+}
+
+// renderEvents fills the events table
+func (v *LatencyView) renderEvents(err error) {
+	v.eventsTable.Clear()
+	headers := []string{"Event", "Last spike", "Latest", "Max"}
+	for i, header := range headers {
+		v.eventsTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	v.rowOffset = 1
+	title := fmt.Sprintf("Latency Events | latency-monitor-threshold: %s ms", v.threshold)
+	v.eventsTable.SetTitle(title)
+
+	if err != nil {
+		v.eventsTable.SetCell(1, 0, tview.NewTableCell("ERROR").SetTextColor(tcell.ColorRed))
+		v.eventsTable.SetCell(1, 1, tview.NewTableCell(err.Error()).SetTextColor(tcell.ColorRed))
+		return
+	}
+
+	if v.threshold == "0" {
+		v.rowOffset = 2
+		v.eventsTable.SetTitle(title + " [disabled]")
+		v.eventsTable.SetCell(1, 0, tview.NewTableCell("Latency monitoring is disabled because latency-monitor-threshold is 0. Press t to enable it.").
+			SetTextColor(tcell.ColorOrange).
+			SetSelectable(false))
+		if len(v.events) == 0 {
+			v.chart.SetText("")
+			return
+		}
+	}
+
+	selectedRow := 0
+	for i, e := range v.events {
+		row := i + v.rowOffset
+		v.eventsTable.SetCell(row, 0, tview.NewTableCell(e.Name).SetTextColor(tcell.ColorGreen))
+		v.eventsTable.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%s (%s ago)",
+			e.LastSpike.Format("15:04:05"), time.Since(e.LastSpike).Round(time.Second))))
+		v.eventsTable.SetCell(row, 2, tview.NewTableCell(e.Latest.String()).SetAlign(tview.AlignRight))
+		v.eventsTable.SetCell(row, 3, tview.NewTableCell(e.Max.String()).SetAlign(tview.AlignRight).SetTextColor(tcell.ColorOrange))
+		if e.Name == v.selected {
+			selectedRow = row
+		}
+	}
+
+	if len(v.events) == 0 {
+		v.selected = ""
+		v.chart.SetTitle("History")
+		v.chart.SetText("No latency spikes recorded")
+		return
+	}
+	if selectedRow == 0 {
+		selectedRow = v.rowOffset
+		v.selected = v.events[0].Name
+	}
+	v.eventsTable.Select(selectedRow, 0)
+	v.loadHistory()
+}
+
+// loadHistory draws LATENCY HISTORY of the selected event
+func (v *LatencyView) loadHistory() {
+	if v.selected == "" {
+		return
+	}
+
+	samples, err := v.redis.GetLatencyHistory(v.selected)
+	if err != nil {
+		v.chart.SetText(err.Error())
+		return
+	}
+
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		values[i] = float64(s.Latency.Milliseconds())
+	}
+
+	_, _, width, height := v.chart.GetInnerRect()
+	if width <= 0 {
+		width, height = 80, 12
+	}
+	text := barChart(values, width, height-2, "ms")
+	if len(samples) > 0 {
+		text += fmt.Sprintf("\n%s → %s, %d samples", samples[0].Time.Format("15:04:05"), samples[len(samples)-1].Time.Format("15:04:05"), len(samples))
+	}
+
+	v.chart.SetTitle(fmt.Sprintf("History: %s", v.selected))
+	v.chart.SetText(text)
+}
+
+// toggleDoctor switches the bottom pane between the history chart and LATENCY DOCTOR
+func (v *LatencyView) toggleDoctor() {
+	if name, _ := v.bottom.GetFrontPage(); name == "doctor" {
+		v.bottom.SwitchToPage("chart")
+		return
+	}
+	v.loadDoctor()
+	v.bottom.SwitchToPage("doctor")
+}
+
+// loadDoctor fetches the LATENCY DOCTOR report
+func (v *LatencyView) loadDoctor() {
+	report, err := v.redis.LatencyDoctor()
+	if err != nil {
+		report = err.Error()
+	}
+	v.doctor.SetText(report).ScrollToBeginning()
+}
+
+// reset clears the history of the given events, or all events
+func (v *LatencyView) reset(events ...string) {
+	n, err := v.redis.ResetLatency(events...)
+	if err != nil {
+		v.host.showMessage(err.Error())
+		return
+	}
+	logger.Infof("[LatencyView] Reset %d latency events", n)
+	v.Refresh()
+}
+
+// showThresholdDialog sets latency-monitor-threshold with CONFIG SET
+func (v *LatencyView) showThresholdDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "latency_threshold"
+
+	threshold := v.threshold
+	if threshold == "" || threshold == "0" {
+		threshold = defaultLatencyThreshold
+	}
+
+	form := v.host.newDialogForm(name, "Latency monitor (CONFIG SET)")
+	form.AddInputField("Threshold (ms)", threshold, 8, tview.InputFieldInteger, func(text string) {
+		threshold = text
+	})
+	form.AddTextView("", "Events slower than this are recorded; 0 disables the latency monitor", 50, 2, true, false)
+	form.AddButton("Apply", func() {
+		v.host.closeDialog(name)
+		if _, err := strconv.Atoi(threshold); err != nil {
+			v.host.showMessage("Threshold must be a number of milliseconds")
+			return
+		}
+		if err := v.redis.SetConfigValue("latency-monitor-threshold", threshold); err != nil {
+			v.host.showMessage(err.Error())
+			return
+		}
+		v.Refresh()
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 60, 10)
+}
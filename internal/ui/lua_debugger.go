@@ -0,0 +1,396 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// luaDebugger is the state of a SCRIPT DEBUG session in the Lua workbench
+type luaDebugger struct {
+	session     *redis.DebugSession
+	lines       []string // Source of the script being debugged
+	current     int      // Line the debugger stopped at, 1-based
+	cursor      int      // Line breakpoints are toggled on
+	breakpoints map[int]bool
+	busy        bool // A command is waiting for the server
+}
+
+// setupDebugger creates the source pane and the LDB command input
+func (v *LuaView) setupDebugger() {
+	v.source = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(false)
+	v.source.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+	v.source.SetInputCapture(v.handleDebugKey)
+
+	v.debugInput = tview.NewInputField().
+		SetLabel("ldb> ").
+		SetFieldWidth(0)
+	v.debugInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			args, err := cmdline.Split(v.debugInput.GetText())
+			if err != nil {
+				v.host.showMessage(err.Error())
+				return
+			}
+			if len(args) == 0 {
+				return
+			}
+			v.debugInput.SetText("")
+			v.debugCommand(args...)
+		case tcell.KeyEscape:
+			v.host.setFocus(v.source)
+		}
+	})
+}
+
+// startDebug runs the script under the debugger; sync sessions block the server and keep writes
+func (v *LuaView) startDebug(sync bool) {
+	if v.debugger != nil {
+		v.host.showMessage("A debugging session is already running")
+		return
+	}
+	if v.libraryMode {
+		v.host.showMessage("Only EVAL scripts can be debugged; switch to the script with Ctrl+T")
+		return
+	}
+	if sync && readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	if sync {
+		v.host.confirm("SCRIPT DEBUG SYNC blocks the server for the whole session and keeps the script's writes. Continue?", func() {
+			v.beginDebug(true)
+		})
+		return
+	}
+	v.beginDebug(false)
+}
+
+// beginDebug starts the session in the background and swaps the editor for the source pane
+func (v *LuaView) beginDebug(sync bool) {
+	keys, args, ok := v.scriptArgs()
+	if !ok {
+		return
+	}
+	script := v.script()
+	d := &luaDebugger{
+		lines:       strings.Split(strings.TrimRight(script, "\n"), "\n"),
+		breakpoints: make(map[int]bool),
+		busy:        true,
+	}
+	v.debugger = d
+
+	mode := "YES"
+	if sync {
+		mode = "SYNC"
+	}
+	v.appendOutput(fmt.Sprintf("[green]> SCRIPT DEBUG %s[white]", mode))
+	v.showDebugger(true)
+
+	go func() {
+		session, logs, err := v.redis.StartDebug(context.Background(), script, keys, args, sync)
+		v.host.queueUpdate(func() {
+			if v.debugger != d {
+				if session != nil {
+					session.Close()
+				}
+				return
+			}
+			if err != nil {
+				v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+				v.endDebug()
+				return
+			}
+			d.session = session
+			v.handleDebugLogs(logs)
+		})
+	}()
+}
+
+// debugCommand sends an LDB command in the background and shows its logs
+func (v *LuaView) debugCommand(args ...string) {
+	d := v.debugger
+	if d == nil || d.session == nil {
+		return
+	}
+	if d.busy {
+		v.appendOutput("[yellow]The debugger is busy; wait for the previous command[white]")
+		return
+	}
+	d.busy = true
+	v.updateSourceTitle()
+	v.appendOutput(fmt.Sprintf("[green]ldb> %s[white]", tview.Escape(redis.QuoteArgs(args))))
+
+	go func() {
+		logs, err := d.session.Command(args...)
+		v.host.queueUpdate(func() {
+			if v.debugger != d {
+				return
+			}
+			if err != nil {
+				v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+				v.endDebug()
+				return
+			}
+			v.trackBreakpoint(args, logs)
+			v.handleDebugLogs(logs)
+		})
+	}()
+}
+
+// handleDebugLogs prints debugger output, moves the current line and ends a finished session
+func (v *LuaView) handleDebugLogs(logs []string) {
+	d := v.debugger
+	d.busy = false
+	for _, line := range logs {
+		v.appendOutput(formatDebugLog(line))
+	}
+	if line := redis.DebugStoppedAt(logs); line > 0 {
+		d.current, d.cursor = line, line
+	}
+
+	if d.session != nil && d.session.Ended() {
+		result, err := d.session.Result()
+		if err != nil {
+			v.appendOutput(fmt.Sprintf("[red](error) %s[white]", tview.Escape(err.Error())))
+		} else {
+			v.appendOutput(formatResult(result))
+		}
+		v.appendOutput("[gray]Debugging session ended[white]")
+		v.endDebug()
+		return
+	}
+	v.renderSource()
+}
+
+// trackBreakpoint keeps the breakpoint markers in step with accepted break commands
+func (v *LuaView) trackBreakpoint(args []string, logs []string) {
+	if len(args) < 2 || (args[0] != "break" && args[0] != "b") {
+		return
+	}
+	var line int
+	if _, err := fmt.Sscan(args[1], &line); err != nil {
+		return
+	}
+	failed := false
+	for _, l := range logs {
+		if strings.HasPrefix(l, "Wrong line") || strings.HasPrefix(l, "No breakpoint") {
+			failed = true
+		}
+	}
+	switch {
+	case failed:
+	case line == 0:
+		v.debugger.breakpoints = make(map[int]bool)
+	case line > 0:
+		v.debugger.breakpoints[line] = true
+	default:
+		delete(v.debugger.breakpoints, -line)
+	}
+}
+
+// stopDebug aborts a running session by closing its connection, reporting whether one was running
+func (v *LuaView) stopDebug() bool {
+	if v.debugger == nil {
+		return false
+	}
+	if v.debugger.session != nil {
+		v.debugger.session.Close()
+	}
+	v.appendOutput("[gray]Debugging session closed[white]")
+	v.endDebug()
+	return true
+}
+
+// handleEscape moves focus from the LDB input back to the source, reporting whether it did
+func (v *LuaView) handleEscape() bool {
+	if v.debugger != nil && v.debugInput.HasFocus() {
+		v.host.setFocus(v.source)
+		return true
+	}
+	return false
+}
+
+// endDebug leaves debugger mode and restores the editor
+func (v *LuaView) endDebug() {
+	v.debugger = nil
+	v.showDebugger(false)
+}
+
+// showDebugger swaps the editor and the KEYS/ARGV inputs for the source pane and the LDB input
+func (v *LuaView) showDebugger(on bool) {
+	v.editorPane.Clear()
+	if on {
+		v.editorPane.
+			AddItem(v.source, 0, 1, true).
+			AddItem(v.debugInput, 1, 0, false)
+		v.panes = []tview.Primitive{v.source, v.debugInput, v.functions, v.output}
+		v.renderSource()
+		v.host.setFocus(v.source)
+		return
+	}
+	v.editorPane.
+		AddItem(v.editor, 0, 1, true).
+		AddItem(v.inputs, 1, 0, false)
+	v.panes = []tview.Primitive{v.editor, v.keysInput, v.argvInput, v.functions, v.output}
+	v.host.setFocus(v.editor)
+}
+
+// handleDebugKey maps single keys of the source pane to LDB commands
+func (v *LuaView) handleDebugKey(event *tcell.EventKey) *tcell.EventKey {
+	d := v.debugger
+	if d == nil {
+		return event
+	}
+
+	switch event.Key() {
+	case tcell.KeyUp:
+		v.moveCursor(-1)
+		return nil
+	case tcell.KeyDown:
+		v.moveCursor(1)
+		return nil
+	}
+
+	switch event.Rune() {
+	case 's', 'n':
+		v.debugCommand("step")
+	case 'c':
+		v.debugCommand("continue")
+	case 'b':
+		if d.breakpoints[d.cursor] {
+			v.debugCommand("break", fmt.Sprintf("-%d", d.cursor))
+		} else {
+			v.debugCommand("break", fmt.Sprint(d.cursor))
+		}
+	case 'B':
+		v.debugCommand("break", "0")
+	case 'p':
+		v.debugCommand("print")
+	case 't':
+		v.debugCommand("trace")
+	case 'a':
+		v.debugCommand("abort")
+	case 'k':
+		v.moveCursor(-1)
+	case 'j':
+		v.moveCursor(1)
+	case 'e':
+		v.promptDebug("eval ")
+	case 'r':
+		v.promptDebug("redis ")
+	case 'i':
+		v.promptDebug("")
+	case 'q':
+		v.stopDebug()
+	default:
+		return event
+	}
+	return nil
+}
+
+// promptDebug focuses the LDB input with a command started
+func (v *LuaView) promptDebug(text string) {
+	v.debugInput.SetText(text)
+	v.host.setFocus(v.debugInput)
+}
+
+// moveCursor moves the breakpoint cursor up or down the source
+func (v *LuaView) moveCursor(step int) {
+	d := v.debugger
+	d.cursor += step
+	if d.cursor < 1 {
+		d.cursor = 1
+	}
+	if d.cursor > len(d.lines) {
+		d.cursor = len(d.lines)
+	}
+	v.renderSource()
+}
+
+// renderSource draws the script with line numbers, breakpoints, the cursor and the current line
+func (v *LuaView) renderSource() {
+	d := v.debugger
+	if d == nil {
+		return
+	}
+	v.source.SetText(renderDebugSource(d.lines, d.current, d.cursor, d.breakpoints))
+	if d.cursor > 5 {
+		v.source.ScrollTo(d.cursor-5, 0)
+	} else {
+		v.source.ScrollToBeginning()
+	}
+	v.updateSourceTitle()
+}
+
+// updateSourceTitle shows the session mode, where it stopped and the keys
+func (v *LuaView) updateSourceTitle() {
+	d := v.debugger
+	if d == nil {
+		return
+	}
+	state := "starting"
+	switch {
+	case d.busy && d.session != nil:
+		state = "running"
+	case d.current > 0:
+		state = fmt.Sprintf("stopped at line %d", d.current)
+	}
+	mode := "forked"
+	if d.session != nil && d.session.Sync() {
+		mode = "SYNC"
+	}
+	v.source.SetTitle(fmt.Sprintf("Debugging (%s) - %s - s step, c continue, b breakpoint, p locals, t trace, e eval, r redis, a abort", mode, state))
+}
+
+// renderDebugSource renders the source pane text; the current line is highlighted, breakpoints are marked with ● and the cursor with ▶
+func renderDebugSource(lines []string, current, cursor int, breakpoints map[int]bool) string {
+	var b strings.Builder
+	for i, code := range lines {
+		n := i + 1
+		marker := " "
+		if breakpoints[n] {
+			marker = "[red]●[-]"
+		}
+		pointer := " "
+		if n == cursor {
+			pointer = "[yellow]▶[-]"
+		}
+		text := tview.Escape(strings.ReplaceAll(code, "\t", "    "))
+		if n == current {
+			text = "[black:yellow]" + text + "[-:-]"
+		}
+		fmt.Fprintf(&b, "%s%s[gray]%4d[-] %s\n", marker, pointer, n, text)
+	}
+	return b.String()
+}
+
+// formatDebugLog colours a debugger log line by its kind; redis.call tracing shows as <redis> and <reply>
+func formatDebugLog(line string) string {
+	color := "white"
+	switch {
+	case strings.HasPrefix(line, "<redis>"):
+		color = "cyan"
+	case strings.HasPrefix(line, "<reply>"):
+		color = "yellow"
+	case strings.HasPrefix(line, "<value>"), strings.HasPrefix(line, "<retval>"):
+		color = "green"
+	case strings.HasPrefix(line, "<error>"):
+		color = "red"
+	case strings.HasPrefix(line, "<debug>"), strings.HasPrefix(line, "<hint>"):
+		color = "fuchsia"
+	case strings.HasPrefix(line, "* "):
+		color = "gray"
+	}
+	return fmt.Sprintf("[%s]%s[white]", color, tview.Escape(line))
+}
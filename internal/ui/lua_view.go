@@ -0,0 +1,728 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/cmdline"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+const (
+	// luaMaxOutputLines is how many lines the workbench output keeps
+	luaMaxOutputLines = 2000
+
+	// luaSampleScript is the editor's initial EVAL script
+	luaSampleScript = `-- KEYS and ARGV are filled from the inputs below
+local value = redis.call('GET', KEYS[1])
+return value
+`
+
+	// luaSampleLibrary is the editor's initial function library
+	luaSampleLibrary = `#!lua name=mylib
+
+redis.register_function('myget', function(keys, args)
+  return redis.call('GET', keys[1])
+end)
+`
+)
+
+// luaFunctionRef identifies a row of the functions table
+type luaFunctionRef struct {
+	library  string
+	function string // Empty for a library without functions
+}
+
+// LuaView is a workbench for EVAL scripts and Redis 7 function libraries
+type LuaView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex      *tview.Flex
+	editor    *tview.TextArea
+	keysInput *tview.InputField
+	argvInput *tview.InputField
+	functions *tview.Table
+	output    *tview.TextView
+	inputs    *tview.Flex
+	panes     []tview.Primitive // Focus order for Ctrl+N and Shift+Tab
+
+	// Debugger components, shown in place of the editor and inputs
+	editorPane *tview.Flex
+	source     *tview.TextView
+	debugInput *tview.InputField
+
+	// State
+	libraryMode bool   // The editor holds a function library instead of a script
+	otherText   string // The buffer of the mode not shown
+	libraries   []redis.FunctionLibrary
+	debugger    *luaDebugger // Running SCRIPT DEBUG session
+	running     string       // EVAL, EVALSHA or FCALL command in progress, empty when idle
+}
+
+// NewLuaView creates a new Lua workbench view
+func NewLuaView(redisClient *redis.Client) *LuaView {
+	view := &LuaView{
+		redis:     redisClient,
+		otherText: luaSampleLibrary,
+	}
+
+	view.setupUI()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *LuaView) setupUI() {
+	v.editor = tview.NewTextArea().
+		SetText(luaSampleScript, false)
+	v.editor.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+	v.editor.SetChangedFunc(v.updateEditorTitle)
+
+	v.keysInput = tview.NewInputField().
+		SetLabel("KEYS: ").
+		SetFieldWidth(0)
+	v.argvInput = tview.NewInputField().
+		SetLabel("ARGV: ").
+		SetFieldWidth(0)
+	v.inputs = tview.NewFlex().
+		AddItem(v.keysInput, 0, 1, false).
+		AddItem(tview.NewBox(), 2, 0, false).
+		AddItem(v.argvInput, 0, 1, false)
+
+	v.functions = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.functions.SetBorder(true).
+		SetTitle("Functions").
+		SetTitleAlign(tview.AlignLeft)
+	v.functions.SetSelectedFunc(func(row, column int) {
+		v.openLibrary()
+	})
+	v.functions.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 'd', 'D':
+			v.deleteLibrary()
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+
+	v.output = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetMaxLines(luaMaxOutputLines)
+	v.output.SetBorder(true).
+		SetTitle("Output").
+		SetTitleAlign(tview.AlignLeft)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]Ctrl+G[white] run (EVAL / FUNCTION LOAD REPLACE / FCALL selected / continue)  [yellow]Ctrl+P[white] actions (debug with g)  [yellow]Ctrl+K[white] kill  [yellow]Ctrl+T[white] script/library  [yellow]Ctrl+N[white] next pane  [yellow]Enter[white] open library")
+
+	v.setupDebugger()
+
+	v.editorPane = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.editor, 0, 1, true).
+		AddItem(v.inputs, 1, 0, false)
+	top := tview.NewFlex().
+		AddItem(v.editorPane, 0, 3, true).
+		AddItem(v.functions, 0, 2, false)
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(top, 0, 3, true).
+		AddItem(v.output, 0, 2, false).
+		AddItem(help, 1, 0, false)
+	v.flex.SetInputCapture(v.handleInput)
+
+	v.panes = []tview.Primitive{v.editor, v.keysInput, v.argvInput, v.functions, v.output}
+	v.updateEditorTitle()
+	v.renderFunctions(nil)
+}
+
+// GetComponent returns the main component
+func (v *LuaView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *LuaView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// Refresh reloads the function libraries
+func (v *LuaView) Refresh() {
+	libs, err := v.redis.FunctionList(true)
+	if err != nil {
+		v.libraries = nil
+		v.renderFunctions(err)
+		return
+	}
+	v.libraries = libs
+	v.renderFunctions(nil)
+}
+
+// handleInput handles the workbench's key bindings
+func (v *LuaView) handleInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyCtrlG:
+		v.run()
+		return nil
+	case tcell.KeyCtrlP:
+		v.showActions()
+		return nil
+	case tcell.KeyCtrlK:
+		v.killScript()
+		return nil
+	case tcell.KeyCtrlT:
+		if v.debugger == nil {
+			v.toggleMode()
+		}
+		return nil
+	case tcell.KeyCtrlN:
+		v.cycleFocus(1)
+		return nil
+	case tcell.KeyBacktab:
+		v.cycleFocus(-1)
+		return nil
+	case tcell.KeyTab:
+		// The editor indents with Tab; other panes move on
+		if v.debugger != nil || !v.editor.HasFocus() {
+			v.cycleFocus(1)
+			return nil
+		}
+	}
+	return event
+}
+
+// cycleFocus moves focus to the next or previous pane
+func (v *LuaView) cycleFocus(step int) {
+	current := 0
+	for i, p := range v.panes {
+		if p.HasFocus() {
+			current = i
+			break
+		}
+	}
+	next := (current + step + len(v.panes)) % len(v.panes)
+	v.host.setFocus(v.panes[next])
+}
+
+// toggleMode switches the editor between the EVAL script and the function library
+func (v *LuaView) toggleMode() {
+	text := v.editor.GetText()
+	v.libraryMode = !v.libraryMode
+	v.editor.SetText(v.otherText, false)
+	v.otherText = text
+	v.updateEditorTitle()
+}
+
+// updateEditorTitle shows the editor's mode and, for scripts, the SHA1 EVALSHA uses
+func (v *LuaView) updateEditorTitle() {
+	if v.libraryMode {
+		v.editor.SetTitle("Library (FUNCTION LOAD)")
+		return
+	}
+	v.editor.SetTitle(fmt.Sprintf("Script (EVAL) sha1 %s", redis.ScriptSHA(v.editor.GetText())))
+}
+
+// run does what Ctrl+G means in the focused pane; read-only connections use EVAL_RO and FCALL_RO
+func (v *LuaView) run() {
+	switch {
+	case v.debugger != nil:
+		v.debugCommand("continue")
+	case v.functions.HasFocus():
+		v.fcall(v.redis.ReadOnly())
+	case v.libraryMode:
+		v.loadLibrary(true)
+	default:
+		v.eval(v.redis.ReadOnly())
+	}
+}
+
+// scriptArgs reads the KEYS and ARGV inputs, quoted like CLI arguments
+func (v *LuaView) scriptArgs() (keys, args []string, ok bool) {
+	keys, err := cmdline.Split(v.keysInput.GetText())
+	if err != nil {
+		v.host.showMessage(fmt.Sprintf("KEYS: %v", err))
+		return nil, nil, false
+	}
+	args, err = cmdline.Split(v.argvInput.GetText())
+	if err != nil {
+		v.host.showMessage(fmt.Sprintf("ARGV: %v", err))
+		return nil, nil, false
+	}
+	return keys, args, true
+}
+
+// script returns the EVAL script, which stays in its buffer while a library is edited
+func (v *LuaView) script() string {
+	if v.libraryMode {
+		return v.otherText
+	}
+	return v.editor.GetText()
+}
+
+// library returns the function library code
+func (v *LuaView) library() string {
+	if v.libraryMode {
+		return v.editor.GetText()
+	}
+	return v.otherText
+}
+
+// eval runs the script with EVAL or EVAL_RO
+func (v *LuaView) eval(readOnly bool) {
+	keys, args, ok := v.scriptArgs()
+	if !ok {
+		return
+	}
+	script := v.script()
+	v.startCall(evalCommandName("EVAL", readOnly), redis.ScriptSHA(script)[:12]+"…", keys, args, func() (interface{}, error) {
+		return v.redis.Eval(script, keys, args, readOnly)
+	}, nil)
+}
+
+// evalSHA runs the cached script with EVALSHA or EVALSHA_RO
+func (v *LuaView) evalSHA(readOnly bool) {
+	keys, args, ok := v.scriptArgs()
+	if !ok {
+		return
+	}
+	sha := redis.ScriptSHA(v.script())
+	v.startCall(evalCommandName("EVALSHA", readOnly), sha, keys, args, func() (interface{}, error) {
+		return v.redis.EvalSHA(sha, keys, args, readOnly)
+	}, func(err error) {
+		if err != nil && strings.HasPrefix(err.Error(), "NOSCRIPT") {
+			v.appendOutput("[gray]The script is not cached; use SCRIPT LOAD (Ctrl+P) first[white]")
+		}
+	})
+}
+
+// fcall calls the selected function with FCALL or FCALL_RO
+func (v *LuaView) fcall(readOnly bool) {
+	ref, ok := v.selectedFunction()
+	if !ok || ref.function == "" {
+		v.host.showMessage("Select a function in the Functions table first")
+		return
+	}
+	keys, args, ok := v.scriptArgs()
+	if !ok {
+		return
+	}
+	v.startCall(evalCommandName("FCALL", readOnly), ref.function, keys, args, func() (interface{}, error) {
+		return v.redis.FCall(ref.function, keys, args, readOnly)
+	}, nil)
+}
+
+// startCall runs a script or function call in the background and prints its reply, then
+// calls done if set; while it runs, Ctrl+K offers SCRIPT KILL or FUNCTION KILL
+func (v *LuaView) startCall(cmd, target string, keys, args []string, call func() (interface{}, error), done func(err error)) {
+	if v.running != "" {
+		v.host.showMessage(fmt.Sprintf("%s is still running; press Ctrl+K to kill it", v.running))
+		return
+	}
+	v.running = cmd
+	v.output.SetTitle(fmt.Sprintf("Output - %s running (Ctrl+K to kill)", cmd))
+
+	go func() {
+		reply, err := call()
+		v.host.queueUpdate(func() {
+			v.running = ""
+			v.output.SetTitle("Output")
+			v.showReply(cmd, target, keys, args, reply, err)
+			if done != nil {
+				done(err)
+			}
+		})
+	}()
+}
+
+// killScript asks to stop the running call with SCRIPT KILL, or FUNCTION KILL for FCALL
+func (v *LuaView) killScript() {
+	if v.running == "" {
+		v.host.showMessage("No script or function is running")
+		return
+	}
+	cmd, kill := "SCRIPT KILL", v.redis.ScriptKill
+	if strings.HasPrefix(v.running, "FCALL") {
+		cmd, kill = "FUNCTION KILL", v.redis.FunctionKill
+	}
+
+	v.host.confirm(fmt.Sprintf("Stop the running %s with %s?\nScripts that already wrote data cannot be killed.", v.running, cmd), func() {
+		v.appendOutput(fmt.Sprintf("[green]> %s[white]", cmd))
+		go func() {
+			err := kill()
+			v.host.queueUpdate(func() {
+				if err != nil {
+					v.appendOutput(fmt.Sprintf("[red](error) %s[white]", tview.Escape(err.Error())))
+					return
+				}
+				v.appendOutput("[yellow]Kill requested; the call returns with an error[white]")
+			})
+		}()
+	})
+}
+
+// evalCommandName adds the _RO suffix of the read-only variants
+func evalCommandName(cmd string, readOnly bool) string {
+	if readOnly {
+		return cmd + "_RO"
+	}
+	return cmd
+}
+
+// showReply prints a command shaped like EVAL followed by its reply
+func (v *LuaView) showReply(cmd, target string, keys, args []string, reply interface{}, err error) {
+	line := fmt.Sprintf("%s %s %d", cmd, target, len(keys))
+	if len(keys) > 0 {
+		line += " " + redis.QuoteArgs(keys)
+	}
+	if len(args) > 0 {
+		line += " " + redis.QuoteArgs(args)
+	}
+	v.appendOutput(fmt.Sprintf("[green]> %s[white]", tview.Escape(line)))
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red](error) %s[white]", tview.Escape(err.Error())))
+		return
+	}
+	v.appendOutput(formatResult(reply))
+}
+
+// scriptLoad caches the script on the server
+func (v *LuaView) scriptLoad() {
+	sha, err := v.redis.ScriptLoad(v.script())
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+		return
+	}
+	v.appendOutput(fmt.Sprintf("[green]> SCRIPT LOAD[white]\n[yellow]%s[white]", sha))
+}
+
+// scriptExists asks which digests are cached, defaulting to the script's own
+func (v *LuaView) scriptExists() {
+	const name = "lua-script-exists"
+	form := v.host.newDialogForm(name, "SCRIPT EXISTS")
+	form.AddInputField("SHA1 digests", redis.ScriptSHA(v.script()), 0, nil, nil)
+	form.AddButton("Check", func() {
+		shas := strings.Fields(form.GetFormItemByLabel("SHA1 digests").(*tview.InputField).GetText())
+		v.closeDialog(name)
+		if len(shas) == 0 {
+			return
+		}
+		exists, err := v.redis.ScriptExists(shas...)
+		if err != nil {
+			v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+			return
+		}
+		v.appendOutput("[green]> SCRIPT EXISTS[white]")
+		for i, sha := range shas {
+			state := "[red]not cached[white]"
+			if i < len(exists) && exists[i] {
+				state = "[green]cached[white]"
+			}
+			v.appendOutput(fmt.Sprintf("%s %s", tview.Escape(sha), state))
+		}
+	})
+	form.AddButton("Cancel", func() {
+		v.closeDialog(name)
+	})
+	v.host.showDialog(name, form, 70, 7)
+}
+
+// scriptFlush empties the script cache after confirmation
+func (v *LuaView) scriptFlush() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	v.host.confirm("Flush every cached script (SCRIPT FLUSH)?", func() {
+		if err := v.redis.ScriptFlush(); err != nil {
+			v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+			return
+		}
+		v.appendOutput("[green]> SCRIPT FLUSH[white]\n[yellow]OK[white]")
+	})
+}
+
+// loadLibrary loads the library code with FUNCTION LOAD, replacing a library of the same name when replace is set
+func (v *LuaView) loadLibrary(replace bool) {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	name, err := v.redis.FunctionLoad(v.library(), replace)
+	cmd := "FUNCTION LOAD"
+	if replace {
+		cmd += " REPLACE"
+	}
+	v.appendOutput(fmt.Sprintf("[green]> %s[white]", cmd))
+	if err != nil {
+		v.appendOutput(fmt.Sprintf("[red]%s[white]", tview.Escape(err.Error())))
+		return
+	}
+	v.appendOutput(fmt.Sprintf("[yellow]%s[white]", tview.Escape(name)))
+	v.Refresh()
+}
+
+// selectedFunction returns the library and function of the selected row
+func (v *LuaView) selectedFunction() (luaFunctionRef, bool) {
+	row, _ := v.functions.GetSelection()
+	ref, ok := v.functions.GetCell(row, 0).GetReference().(luaFunctionRef)
+	return ref, ok
+}
+
+// findLibrary returns a loaded library by name
+func (v *LuaView) findLibrary(name string) *redis.FunctionLibrary {
+	for i := range v.libraries {
+		if v.libraries[i].Name == name {
+			return &v.libraries[i]
+		}
+	}
+	return nil
+}
+
+// openLibrary shows the selected library's code in the editor
+func (v *LuaView) openLibrary() {
+	ref, ok := v.selectedFunction()
+	if !ok {
+		return
+	}
+	lib := v.findLibrary(ref.library)
+	if lib == nil {
+		return
+	}
+	if !v.libraryMode {
+		v.toggleMode()
+	}
+	v.editor.SetText(lib.Code, false)
+	v.host.setFocus(v.editor)
+}
+
+// deleteLibrary removes the selected library after confirmation
+func (v *LuaView) deleteLibrary() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	ref, ok := v.selectedFunction()
+	if !ok {
+		return
+	}
+	v.host.confirm(fmt.Sprintf("Delete library %q and all of its functions?", ref.library), func() {
+		if err := v.redis.FunctionDelete(ref.library); err != nil {
+			v.host.showMessage(err.Error())
+			return
+		}
+		v.appendOutput(fmt.Sprintf("[green]> FUNCTION DELETE %s[white]\n[yellow]OK[white]", tview.Escape(ref.library)))
+		v.Refresh()
+	})
+}
+
+// renderFunctions fills the functions table, one row per function
+func (v *LuaView) renderFunctions(err error) {
+	v.functions.Clear()
+	for i, header := range []string{"Library", "Function", "Flags", "Description"} {
+		v.functions.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetSelectable(false))
+	}
+
+	if err != nil {
+		v.functions.SetTitle("Functions")
+		v.functions.SetCell(1, 0, tview.NewTableCell(tview.Escape("Functions unavailable (Redis 7+): "+err.Error())).
+			SetTextColor(tcell.ColorGray).
+			SetSelectable(false))
+		return
+	}
+
+	v.functions.SetTitle(fmt.Sprintf("Functions (%d libraries)", len(v.libraries)))
+	row := 1
+	for _, lib := range v.libraries {
+		if len(lib.Functions) == 0 {
+			v.functions.SetCell(row, 0, tview.NewTableCell(tview.Escape(lib.Name)).
+				SetReference(luaFunctionRef{library: lib.Name}))
+			v.functions.SetCell(row, 1, tview.NewTableCell("(none)").SetTextColor(tcell.ColorGray))
+			row++
+			continue
+		}
+		for _, fn := range lib.Functions {
+			v.functions.SetCell(row, 0, tview.NewTableCell(tview.Escape(lib.Name)).
+				SetReference(luaFunctionRef{library: lib.Name, function: fn.Name}))
+			v.functions.SetCell(row, 1, tview.NewTableCell(tview.Escape(fn.Name)).SetTextColor(tcell.ColorGreen))
+			v.functions.SetCell(row, 2, tview.NewTableCell(tview.Escape(strings.Join(fn.Flags, ","))))
+			v.functions.SetCell(row, 3, tview.NewTableCell(tview.Escape(fn.Description)).SetExpansion(1))
+			row++
+		}
+	}
+	if row == 1 {
+		v.functions.SetCell(1, 0, tview.NewTableCell("No libraries loaded").
+			SetTextColor(tcell.ColorGray).
+			SetSelectable(false))
+	}
+}
+
+// luaAction is an entry of the actions menu
+type luaAction struct {
+	label    string
+	shortcut rune
+	run      func()
+}
+
+// showActions lists every script and function command
+func (v *LuaView) showActions() {
+	const name = "lua-actions"
+	actions := []luaAction{
+		{"EVAL", 'e', func() { v.eval(false) }},
+		{"EVAL_RO", 'E', func() { v.eval(true) }},
+		{"EVALSHA", 's', func() { v.evalSHA(false) }},
+		{"EVALSHA_RO", 'S', func() { v.evalSHA(true) }},
+		{"SCRIPT LOAD", 'l', v.scriptLoad},
+		{"SCRIPT EXISTS", 'x', v.scriptExists},
+		{"SCRIPT FLUSH", 'F', v.scriptFlush},
+		{"FUNCTION LOAD", 'n', func() { v.loadLibrary(false) }},
+		{"FUNCTION LOAD REPLACE", 'f', func() { v.loadLibrary(true) }},
+		{"Debug script (SCRIPT DEBUG YES, forked)", 'g', func() { v.startDebug(false) }},
+		{"Debug script (SCRIPT DEBUG SYNC, blocks the server)", 'G', func() { v.startDebug(true) }},
+		{"FCALL selected function", 'c', func() { v.fcall(false) }},
+		{"FCALL_RO selected function", 'C', func() { v.fcall(true) }},
+		{"SCRIPT KILL / FUNCTION KILL the running call", 'k', v.killScript},
+		{"FUNCTION DELETE selected library", 'd', v.deleteLibrary},
+		{"FUNCTION DUMP to file", 'u', v.dumpFunctions},
+		{"FUNCTION RESTORE from file", 'r', v.restoreFunctions},
+		{"Open file in editor", 'o', v.openFile},
+		{"Save editor to file", 'w', v.saveFile},
+	}
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).
+		SetTitle(" Actions ").
+		SetTitleAlign(tview.AlignLeft)
+	for _, a := range actions {
+		run := a.run
+		list.AddItem(a.label, "", a.shortcut, func() {
+			v.closeDialog(name)
+			run()
+		})
+	}
+	list.SetDoneFunc(func() {
+		v.closeDialog(name)
+	})
+	v.host.showDialog(name, list, 60, len(actions)+2)
+}
+
+// closeDialog closes a dialog and returns focus to the editor, or the source while debugging
+func (v *LuaView) closeDialog(name string) {
+	v.host.closeDialog(name)
+	if v.debugger != nil {
+		v.host.setFocus(v.source)
+		return
+	}
+	v.host.setFocus(v.editor)
+}
+
+// askPath asks for a file path and passes it to fn
+func (v *LuaView) askPath(name, title, button, path string, fn func(path string)) {
+	form := v.host.newDialogForm(name, title)
+	form.AddInputField("File", path, 0, nil, nil)
+	form.AddButton(button, func() {
+		file := expandPath(form.GetFormItemByLabel("File").(*tview.InputField).GetText())
+		if file == "" {
+			return
+		}
+		v.closeDialog(name)
+		fn(file)
+	})
+	form.AddButton("Cancel", func() {
+		v.closeDialog(name)
+	})
+	v.host.showDialog(name, form, 70, 7)
+}
+
+// openFile loads a Lua file into the editor
+func (v *LuaView) openFile() {
+	v.askPath("lua-open", "Open File", "Open", "", func(path string) {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Failed to read %s: %v", path, err))
+			return
+		}
+		// Files starting with a shebang are function libraries
+		if strings.HasPrefix(string(data), "#!") != v.libraryMode {
+			v.toggleMode()
+		}
+		v.editor.SetText(string(data), false)
+	})
+}
+
+// saveFile writes the editor to a file
+func (v *LuaView) saveFile() {
+	v.askPath("lua-save", "Save Editor", "Save", "", func(path string) {
+		if err := os.WriteFile(path, []byte(v.editor.GetText()), 0644); err != nil {
+			v.host.showMessage(fmt.Sprintf("Failed to write %s: %v", path, err))
+			return
+		}
+		v.appendOutput(fmt.Sprintf("[gray]Saved %s[white]", tview.Escape(path)))
+	})
+}
+
+// dumpFunctions writes the FUNCTION DUMP payload to a file
+func (v *LuaView) dumpFunctions() {
+	v.askPath("lua-dump", "FUNCTION DUMP to File", "Dump", "functions.rdb", func(path string) {
+		payload, err := v.redis.FunctionDump()
+		if err != nil {
+			v.host.showMessage(err.Error())
+			return
+		}
+		if err := os.WriteFile(path, payload, 0600); err != nil {
+			v.host.showMessage(fmt.Sprintf("Failed to write %s: %v", path, err))
+			return
+		}
+		v.appendOutput(fmt.Sprintf("[green]> FUNCTION DUMP[white]\n[yellow]%d bytes written to %s[white]", len(payload), tview.Escape(path)))
+	})
+}
+
+// restoreFunctions restores libraries from a FUNCTION DUMP file
+func (v *LuaView) restoreFunctions() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "lua-restore"
+	policies := []string{redis.RestoreAppend, redis.RestoreReplace, redis.RestoreFlush}
+	form := v.host.newDialogForm(name, "FUNCTION RESTORE from File")
+	form.AddInputField("File", "functions.rdb", 0, nil, nil)
+	form.AddDropDown("Policy", policies, 0, nil)
+	form.AddButton("Restore", func() {
+		path := expandPath(form.GetFormItemByLabel("File").(*tview.InputField).GetText())
+		_, policy := form.GetFormItemByLabel("Policy").(*tview.DropDown).GetCurrentOption()
+		payload, err := os.ReadFile(path)
+		if err != nil {
+			v.host.showMessage(fmt.Sprintf("Failed to read %s: %v", path, err))
+			return
+		}
+		v.closeDialog(name)
+		if err := v.redis.FunctionRestore(payload, policy); err != nil {
+			v.host.showMessage(err.Error())
+			return
+		}
+		v.appendOutput(fmt.Sprintf("[green]> FUNCTION RESTORE %s[white]\n[yellow]OK[white]", policy))
+		v.Refresh()
+	})
+	form.AddButton("Cancel", func() {
+		v.closeDialog(name)
+	})
+	v.host.showDialog(name, form, 70, 9)
+}
+
+// appendOutput appends text to the output
+func (v *LuaView) appendOutput(text string) {
+	fmt.Fprintln(v.output, text)
+	v.output.ScrollToEnd()
+}
@@ -0,0 +1,16 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestLuaDebugger tests drawing the source pane and the debugger log
+func TestLuaDebugger(t *testing.T) {
+	source := renderDebugSource([]string{"local a = 1", "return a"}, 2, 1, map[int]bool{2: true})
+	assert.Equal(t, " [yellow]▶[-][gray]   1[-] local a = 1\n[red]●[-] [gray]   2[-] [black:yellow]return a[-:-]\n", source)
+
+	assert.Equal(t, "[cyan]<redis> GET foo[white]", formatDebugLog("<redis> GET foo"))
+	assert.Equal(t, "[red]<error> Wrong line number.[white]", formatDebugLog("<error> Wrong line number."))
+}
@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/utils"
+)
+
+// metricHistorySize holds an hour of samples at the fastest refresh rate
+const metricHistorySize = 3600
+
+// Indexes of the series kept by MetricHistory
+const (
+	metricOpsPerSec = iota
+	metricUsedMemory
+	metricRSS
+	metricFragmentation
+	metricClients
+	metricHitRate
+	metricNetInKbps
+	metricNetOutKbps
+	metricExpiredPerSec
+	metricEvictedPerSec
+	metricCount
+)
+
+// metricSeries describes how a series is labelled and formatted
+type metricSeries struct {
+	name   string
+	format func(v float64) string
+}
+
+// metricSeriesDefs are indexed by the metric* constants
+var metricSeriesDefs = [metricCount]metricSeries{
+	metricOpsPerSec:     {"Ops/sec", formatRate},
+	metricUsedMemory:    {"Used Memory", formatByteValue},
+	metricRSS:           {"Used Memory RSS", formatByteValue},
+	metricFragmentation: {"Fragmentation", func(v float64) string { return fmt.Sprintf("%.2f", v) }},
+	metricClients:       {"Clients", func(v float64) string { return fmt.Sprintf("%.0f", v) }},
+	metricHitRate:       {"Hit Rate", func(v float64) string { return fmt.Sprintf("%.1f%%", v) }},
+	metricNetInKbps:     {"Net In", func(v float64) string { return fmt.Sprintf("%.1f kbps", v) }},
+	metricNetOutKbps:    {"Net Out", func(v float64) string { return fmt.Sprintf("%.1f kbps", v) }},
+	metricExpiredPerSec: {"Expired Keys/s", formatRate},
+	metricEvictedPerSec: {"Evicted Keys/s", formatRate},
+}
+
+// metricPoint is one sample of every series
+type metricPoint struct {
+	time   time.Time
+	values [metricCount]float64
+}
+
+// MetricHistory keeps a bounded time series of server metrics, computing rates from counter deltas
+type MetricHistory struct {
+	mu       sync.Mutex
+	points   *utils.Ring[metricPoint]
+	prev     *redis.Metrics
+	prevTime time.Time
+}
+
+// NewMetricHistory creates a history holding at most capacity points
+func NewMetricHistory(capacity int) *MetricHistory {
+	return &MetricHistory{
+		points: utils.NewRing[metricPoint](capacity),
+	}
+}
+
+// Add records a sample; the first sample only primes the counters
+func (h *MetricHistory) Add(m *redis.Metrics, at time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	prev, prevTime := h.prev, h.prevTime
+	h.prev, h.prevTime = m, at
+	if prev == nil {
+		return
+	}
+	elapsed := at.Sub(prevTime).Seconds()
+	if elapsed <= 0 {
+		return
+	}
+
+	// Counters go backwards after a restart or CONFIG RESETSTAT; report zero for that interval
+	rate := func(cur, old int64) float64 {
+		if cur < old {
+			return 0
+		}
+		return float64(cur-old) / elapsed
+	}
+
+	p := metricPoint{time: at}
+	p.values[metricOpsPerSec] = rate(m.TotalCommandsProcessed, prev.TotalCommandsProcessed)
+	p.values[metricUsedMemory] = float64(m.UsedMemory)
+	p.values[metricRSS] = float64(m.UsedMemoryRss)
+	p.values[metricFragmentation] = m.MemFragmentationRatio
+	p.values[metricClients] = float64(m.ConnectedClients)
+	p.values[metricNetInKbps] = rate(m.TotalNetInputBytes, prev.TotalNetInputBytes) * 8 / 1024
+	p.values[metricNetOutKbps] = rate(m.TotalNetOutputBytes, prev.TotalNetOutputBytes) * 8 / 1024
+	p.values[metricExpiredPerSec] = rate(m.ExpiredKeys, prev.ExpiredKeys)
+	p.values[metricEvictedPerSec] = rate(m.EvictedKeys, prev.EvictedKeys)
+
+	// Hit rate of the lookups made during this interval
+	hits := m.KeyspaceHits - prev.KeyspaceHits
+	misses := m.KeyspaceMisses - prev.KeyspaceMisses
+	if hits >= 0 && misses >= 0 && hits+misses > 0 {
+		p.values[metricHitRate] = float64(hits) / float64(hits+misses) * 100
+	} else if last, ok := h.points.Last(); ok {
+		p.values[metricHitRate] = last.values[metricHitRate]
+	}
+
+	h.points.Push(p)
+}
+
+// Series returns the values of one series within the window ending at the latest point
+func (h *MetricHistory) Series(metric int, window time.Duration) []float64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	points := h.points.Items()
+	if len(points) == 0 {
+		return nil
+	}
+
+	since := points[len(points)-1].time.Add(-window)
+	var values []float64
+	for _, p := range points {
+		if !p.time.Before(since) {
+			values = append(values, p.values[metric])
+		}
+	}
+	return values
+}
+
+// Latest returns the most recent point
+func (h *MetricHistory) Latest() (metricPoint, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.points.Last()
+}
+
+// formatRate formats a per-second rate
+func formatRate(v float64) string {
+	if v >= 100 {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+// formatByteValue formats a byte count held as a float
+func formatByteValue(v float64) string {
+	return formatBytes(uint64(v))
+}
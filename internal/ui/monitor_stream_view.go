@@ -0,0 +1,497 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/utils"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// monitorBufferSize is the number of MONITOR entries kept in the ring buffer
+const monitorBufferSize = 5000
+
+// monitorAutoStops are the auto-stop durations cycled with 't'; zero disables the timer
+var monitorAutoStops = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, 30 * time.Second, 0}
+
+// monitorSortColumns are the columns the stream can be sorted by
+var monitorSortColumns = []string{"Time", "DB", "Client", "Command"}
+
+// MonitorStreamView shows the live MONITOR command stream
+type MonitorStreamView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex   *tview.Flex
+	table  *tview.Table
+	status *tview.TextView
+
+	// State
+	mu        sync.Mutex
+	stream    *redis.MonitorStream
+	buffer    *utils.Ring[redis.MonitorEntry]
+	received  int64
+	paused    bool
+	snapshot  []redis.MonitorEntry // Entries shown while paused
+	shown     []redis.MonitorEntry
+	filter    redis.MonitorFilter
+	sortIndex int
+	autoIndex int
+	stopAt    time.Time
+	cancel    context.CancelFunc
+}
+
+// NewMonitorStreamView creates a new MONITOR stream view
+func NewMonitorStreamView(redisClient *redis.Client) *MonitorStreamView {
+	view := &MonitorStreamView{
+		redis:  redisClient,
+		buffer: utils.NewRing[redis.MonitorEntry](monitorBufferSize),
+		filter: redis.MonitorFilter{DB: -1},
+	}
+
+	view.setupUI()
+	view.render()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *MonitorStreamView) setupUI() {
+	v.table = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.table.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	// Enter shows the full arguments of an entry
+	v.table.SetSelectedFunc(func(row, col int) {
+		if row > 0 && row <= len(v.shown) {
+			v.showEntry(v.shown[row-1])
+		}
+	})
+
+	v.status = tview.NewTextView().
+		SetDynamicColors(true)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]s[white] start/stop  [yellow]space[white] pause/resume  [yellow]f[white] filter  [yellow]t[white] auto-stop  [yellow]o[white] sort  [yellow]x[white] export  [yellow]c[white] clear  [yellow]Enter[white] full args")
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.table, 0, 1, true).
+		AddItem(v.status, 1, 0, false).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		switch event.Rune() {
+		case 's', 'S':
+			v.toggle()
+			return nil
+		case ' ', 'p', 'P':
+			v.togglePause()
+			return nil
+		case 'f', 'F':
+			v.showFilterDialog()
+			return nil
+		case 't', 'T':
+			v.cycleAutoStop()
+			return nil
+		case 'o', 'O':
+			v.sortIndex = (v.sortIndex + 1) % len(monitorSortColumns)
+			v.render()
+			return nil
+		case 'x', 'X':
+			v.showExportDialog()
+			return nil
+		case 'c', 'C':
+			v.clear()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *MonitorStreamView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *MonitorStreamView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// toggle starts or stops the stream
+func (v *MonitorStreamView) toggle() {
+	if v.isRunning() {
+		v.stop()
+		return
+	}
+	v.host.confirm("MONITOR streams every command the server executes and can noticeably reduce throughput.\nStart monitoring?", v.start)
+}
+
+// isRunning reports whether a MONITOR connection is open
+func (v *MonitorStreamView) isRunning() bool {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	return v.stream != nil
+}
+
+// start opens the MONITOR connection in the background and the auto-stop timer; a failed
+// dial ends the stream and is reported by receive
+func (v *MonitorStreamView) start() {
+	stream := v.redis.StartMonitor(context.Background())
+	logger.Info("[MonitorStreamView] MONITOR started")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	v.mu.Lock()
+	v.stream = stream
+	v.cancel = cancel
+	v.stopAt = time.Time{}
+	if d := monitorAutoStops[v.autoIndex]; d > 0 {
+		v.stopAt = time.Now().Add(d)
+	}
+	v.mu.Unlock()
+
+	go v.receive(ctx, stream)
+	v.render()
+}
+
+// stop closes the MONITOR connection
+func (v *MonitorStreamView) stop() {
+	v.mu.Lock()
+	stream := v.stream
+	v.stream = nil
+	if v.cancel != nil {
+		v.cancel()
+		v.cancel = nil
+	}
+	v.mu.Unlock()
+
+	if stream != nil {
+		stream.Stop()
+		logger.Info("[MonitorStreamView] MONITOR stopped")
+	}
+	v.render()
+}
+
+// receive buffers entries, redraws at most four times a second and enforces the auto-stop timer
+func (v *MonitorStreamView) receive(ctx context.Context, stream *redis.MonitorStream) {
+	ticker := time.NewTicker(250 * time.Millisecond)
+	defer ticker.Stop()
+
+	entries := stream.Entries()
+	for {
+		select {
+		case entry, ok := <-entries:
+			if !ok {
+				if ctx.Err() != nil {
+					return
+				}
+				err := stream.Err()
+				v.host.queueUpdate(func() {
+					v.stopStream(stream)
+					if err != nil {
+						v.host.showMessage(fmt.Sprintf("MONITOR ended: %v", err))
+					}
+				})
+				return
+			}
+			v.mu.Lock()
+			v.buffer.Push(entry)
+			v.received++
+			v.mu.Unlock()
+		case <-ticker.C:
+			v.mu.Lock()
+			expired := !v.stopAt.IsZero() && time.Now().After(v.stopAt)
+			v.mu.Unlock()
+
+			if expired {
+				v.host.queueUpdate(func() {
+					v.stopStream(stream)
+				})
+				return
+			}
+			// The title shows the remaining auto-stop time, so redraw even without new entries
+			v.host.queueUpdate(v.render)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// stopStream stops the given stream if it is still the current one
+func (v *MonitorStreamView) stopStream(stream *redis.MonitorStream) {
+	v.mu.Lock()
+	current := v.stream == stream
+	v.mu.Unlock()
+	if current {
+		v.stop()
+	}
+}
+
+// togglePause freezes or resumes the display while entries keep being buffered
+func (v *MonitorStreamView) togglePause() {
+	v.mu.Lock()
+	v.paused = !v.paused
+	if v.paused {
+		v.snapshot = v.buffer.Items()
+	} else {
+		v.snapshot = nil
+	}
+	v.mu.Unlock()
+	v.render()
+}
+
+// cycleAutoStop switches to the next auto-stop duration, restarting the timer if running
+func (v *MonitorStreamView) cycleAutoStop() {
+	v.mu.Lock()
+	v.autoIndex = (v.autoIndex + 1) % len(monitorAutoStops)
+	if v.stream != nil {
+		v.stopAt = time.Time{}
+		if d := monitorAutoStops[v.autoIndex]; d > 0 {
+			v.stopAt = time.Now().Add(d)
+		}
+	}
+	v.mu.Unlock()
+	v.render()
+}
+
+// clear empties the buffer
+func (v *MonitorStreamView) clear() {
+	v.mu.Lock()
+	v.buffer.Clear()
+	v.snapshot = nil
+	v.received = 0
+	v.mu.Unlock()
+	v.render()
+}
+
+// visibleEntries returns the filtered and sorted entries to display
+func (v *MonitorStreamView) visibleEntries() []redis.MonitorEntry {
+	v.mu.Lock()
+	source := v.snapshot
+	if !v.paused {
+		source = v.buffer.Items()
+	}
+	filter := v.filter
+	v.mu.Unlock()
+
+	entries := make([]redis.MonitorEntry, 0, len(source))
+	for _, e := range source {
+		if filter.Match(e) {
+			entries = append(entries, e)
+		}
+	}
+
+	switch monitorSortColumns[v.sortIndex] {
+	case "DB":
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].DB < entries[j].DB })
+	case "Client":
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Client < entries[j].Client })
+	case "Command":
+		sort.SliceStable(entries, func(i, j int) bool { return entries[i].Command < entries[j].Command })
+	}
+	return entries
+}
+
+// render redraws the table, title and status line
+func (v *MonitorStreamView) render() {
+	v.shown = v.visibleEntries()
+
+	row, _ := v.table.GetSelection()
+	follow := row >= v.table.GetRowCount()-1 || row <= 0
+
+	v.table.Clear()
+	headers := []string{"Time", "DB", "Client", "Command", "Arguments"}
+	for i, header := range headers {
+		if header == monitorSortColumns[v.sortIndex] {
+			header += " ↑"
+		}
+		v.table.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	for i, e := range v.shown {
+		args := redis.QuoteArgs(e.Args)
+		if len(args) > 120 {
+			args = args[:117] + "..."
+		}
+		v.table.SetCell(i+1, 0, tview.NewTableCell(e.Time.Format("15:04:05.000000")).SetTextColor(tcell.ColorGray))
+		v.table.SetCell(i+1, 1, tview.NewTableCell(strconv.Itoa(e.DB)))
+		v.table.SetCell(i+1, 2, tview.NewTableCell(e.Client))
+		v.table.SetCell(i+1, 3, tview.NewTableCell(e.Command).SetTextColor(tcell.ColorGreen))
+		v.table.SetCell(i+1, 4, tview.NewTableCell(tview.Escape(args)))
+	}
+
+	// Keep following new entries unless the user scrolled up or sorted by another column
+	if len(v.shown) > 0 && follow && v.sortIndex == 0 {
+		v.table.Select(len(v.shown), 0)
+	}
+
+	v.mu.Lock()
+	state := "[red]STOPPED[white]"
+	if v.stream != nil {
+		state = "[green]RUNNING[white]"
+		if !v.stopAt.IsZero() {
+			state += fmt.Sprintf(" (auto-stop in %s)", time.Until(v.stopAt).Round(time.Second))
+		}
+	}
+	if v.paused {
+		state += " [yellow]PAUSED[white]"
+	}
+	autoStop := "off"
+	if d := monitorAutoStops[v.autoIndex]; d > 0 {
+		autoStop = d.String()
+	}
+	buffered := v.buffer.Len()
+	received := v.received
+	filter := v.filter.String()
+	v.mu.Unlock()
+
+	v.table.SetTitle(fmt.Sprintf("MONITOR %s - %d shown", state, len(v.shown)))
+	v.status.SetText(fmt.Sprintf("Buffered: %d/%d  Received: %d  Filter: %s  Auto-stop: %s  Sort: %s",
+		buffered, monitorBufferSize, received, tview.Escape(filter), autoStop, monitorSortColumns[v.sortIndex]))
+}
+
+// showEntry shows a single entry with every argument on its own line
+func (v *MonitorStreamView) showEntry(e redis.MonitorEntry) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s  db%d  %s\n\n%s\n", e.Time.Format("2006-01-02 15:04:05.000000"), e.DB, e.Client, e.Command)
+	for i, arg := range e.Args {
+		fmt.Fprintf(&b, "%d) %s\n", i+1, redis.QuoteArg(arg))
+	}
+	v.host.showMessage(b.String())
+}
+
+// showFilterDialog edits the command, client, DB and key pattern filters
+func (v *MonitorStreamView) showFilterDialog() {
+	const name = "monitor_filter"
+
+	v.mu.Lock()
+	filter := v.filter
+	v.mu.Unlock()
+
+	commands := strings.Join(filter.Commands, " ")
+	db := ""
+	if filter.DB >= 0 {
+		db = strconv.Itoa(filter.DB)
+	}
+
+	form := v.host.newDialogForm(name, "MONITOR filter")
+	form.AddInputField("Commands", commands, 40, nil, func(text string) {
+		commands = text
+	})
+	form.AddInputField("Client address", filter.Client, 40, nil, func(text string) {
+		filter.Client = strings.TrimSpace(text)
+	})
+	form.AddInputField("DB", db, 4, tview.InputFieldInteger, func(text string) {
+		db = text
+	})
+	form.AddInputField("Key pattern", filter.KeyPattern, 40, nil, func(text string) {
+		filter.KeyPattern = strings.TrimSpace(text)
+	})
+	form.AddButton("Apply", func() {
+		v.host.closeDialog(name)
+		filter.Commands = nil
+		for _, cmd := range strings.FieldsFunc(commands, func(r rune) bool { return r == ' ' || r == ',' }) {
+			filter.Commands = append(filter.Commands, strings.ToUpper(cmd))
+		}
+		filter.DB = -1
+		if n, err := strconv.Atoi(db); err == nil {
+			filter.DB = n
+		}
+		v.mu.Lock()
+		v.filter = filter
+		v.mu.Unlock()
+		v.render()
+	})
+	form.AddButton("Clear", func() {
+		v.host.closeDialog(name)
+		v.mu.Lock()
+		v.filter = redis.MonitorFilter{DB: -1}
+		v.mu.Unlock()
+		v.render()
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 64, 14)
+}
+
+// showExportDialog writes the visible entries to a file
+func (v *MonitorStreamView) showExportDialog() {
+	const name = "monitor_export"
+
+	formats := []string{string(redis.FormatJSON), string(redis.FormatCSV)}
+	format := redis.FormatJSON
+	path := fmt.Sprintf("monitor-%s.jsonl", time.Now().Format("20060102-150405"))
+
+	form := v.host.newDialogForm(name, "Export MONITOR entries")
+	form.AddDropDown("Format", formats, 0, func(option string, index int) {
+		format = redis.ExportFormat(option)
+		if item, ok := form.GetFormItemByLabel("File").(*tview.InputField); ok {
+			ext := ".jsonl"
+			if format == redis.FormatCSV {
+				ext = ".csv"
+			}
+			text := item.GetText()
+			item.SetText(strings.TrimSuffix(strings.TrimSuffix(text, ".jsonl"), ".csv") + ext)
+		}
+	})
+	form.AddInputField("File", path, 50, nil, func(text string) {
+		path = text
+	})
+	form.AddButton("Export", func() {
+		v.host.closeDialog(name)
+		entries := v.visibleEntries()
+		if err := exportMonitorEntries(expandPath(path), entries, format); err != nil {
+			v.host.showMessage(fmt.Sprintf("Export failed: %v", err))
+			return
+		}
+		v.host.showMessage(fmt.Sprintf("Exported %d entries to %s", len(entries), path))
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 70, 9)
+}
+
+// exportMonitorEntries writes entries to a new file
+func exportMonitorEntries(path string, entries []redis.MonitorEntry, format redis.ExportFormat) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	if err := redis.WriteMonitorEntries(f, entries, format); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Refresh redraws the stream
+func (v *MonitorStreamView) Refresh() {
+	v.render()
+}
+
+// Close stops MONITOR if it is running
+func (v *MonitorStreamView) Close() {
+	v.stop()
+}
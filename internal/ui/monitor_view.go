@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
 	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/dustin/go-humanize"
@@ -14,21 +16,54 @@ import (
 
 // MonitorView represents the monitoring view
 type MonitorView struct {
-	redis     *redis.Client
-	
+	redis *redis.Client
+	host  *viewHost
+
 	// UI Components
-	flex          *tview.Flex
-	statsTable    *tview.Table
-	commandTable  *tview.Table
-	clientTable   *tview.Table
-	infoText      *tview.TextView
+	flex         *tview.Flex
+	statsTable   *tview.Table
+	commandTable *tview.Table
+	clientTable  *tview.Table
+	infoText     *tview.TextView
+	bottomPages  *tview.Pages
+	chartView    *tview.TextView
+	errorsTable  *tview.Table
 
 	// Monitoring state
-	monitoring    bool
-	ticker        *time.Ticker
-	stopChan      chan bool
-	refreshRate   time.Duration
-	refreshIndex  int // Index for cycling through refresh rates
+	sampleMu     sync.Mutex // Serializes sampling with ResetHistory and resetStats on the UI thread
+	monitoring   bool
+	ticker       *time.Ticker
+	stopChan     chan bool
+	refreshRate  time.Duration
+	refreshIndex int // Index for cycling through refresh rates
+
+	// Metric history for sparklines and charts
+	history     *MetricHistory
+	window      time.Duration
+	chartMetric int
+	lastMetrics *redis.Metrics
+
+	// Previous command stats sample for per-interval rates
+	prevCommands     map[string]redis.CommandStat
+	prevCommandsTime time.Time
+	commandStats     []redis.CommandStat
+	commandRates     map[string]commandRate
+
+	// INFO errorstats rates
+	errors     *errorTracker
+	errorRates []errorRate
+	errorsErr  error
+
+	// onSample receives every INFO sample; the App evaluates the alert rules with it
+	onSample func(info map[string]interface{})
+}
+
+// commandRate is the activity of one command during the last refresh interval
+type commandRate struct {
+	callsPerSec    float64
+	usecPerCall    float64
+	failedPerSec   float64
+	rejectedPerSec float64
 }
 
 // NewMonitorView creates a new monitor view
@@ -39,6 +74,9 @@ func NewMonitorView(redisClient *redis.Client) *MonitorView {
 		stopChan:     make(chan bool),
 		refreshRate:  2 * time.Second, // Default 2 seconds like top
 		refreshIndex: 1,               // Start with 2 seconds
+		history:      NewMetricHistory(metricHistorySize),
+		window:       5 * time.Minute,
+		errors:       newErrorTracker(),
 	}
 
 	view.setupUI()
@@ -61,15 +99,7 @@ func (v *MonitorView) setupUI() {
 		SetTitleAlign(tview.AlignLeft)
 	v.commandTable.SetSelectable(true, false)
 	
-	// Set command table headers
-	headers := []string{"Command", "Number of calls", "Total Duration ↓", "Duration per call", "RejectedCalls", "FailedCalls", "CallsMaster"}
-	for i, header := range headers {
-		cell := tview.NewTableCell(header).
-			SetTextColor(tcell.ColorYellow).
-			SetAlign(tview.AlignLeft).
-			SetSelectable(false)
-		v.commandTable.SetCell(0, i, cell)
-	}
+	v.setCommandHeaders(false)
 
 	// Create client connections table
 	v.clientTable = tview.NewTable()
@@ -114,8 +144,26 @@ func (v *MonitorView) setupUI() {
 	v.flex.AddItem(v.clientTable, 0, 2, false)
 	
 	// Bottom section: Server stats and system info side by side
+	// Trend chart shown in place of the stats table with 'g'
+	v.chartView = tview.NewTextView().
+		SetDynamicColors(false).
+		SetWrap(false)
+	v.chartView.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	// Error statistics shown in place of the stats table with 'e'
+	v.errorsTable = tview.NewTable().
+		SetFixed(1, 0)
+	v.errorsTable.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	v.bottomPages = tview.NewPages().
+		AddPage("stats", v.statsTable, true, true).
+		AddPage("chart", v.chartView, true, false).
+		AddPage("errors", v.errorsTable, true, false)
+
 	bottomFlex := tview.NewFlex().SetDirection(tview.FlexColumn)
-	bottomFlex.AddItem(v.statsTable, 0, 1, false)
+	bottomFlex.AddItem(v.bottomPages, 0, 1, false)
 	bottomFlex.AddItem(v.infoText, 0, 1, false)
 	
 	v.flex.AddItem(bottomFlex, 0, 1, false)
@@ -129,6 +177,18 @@ func (v *MonitorView) GetComponent() tview.Primitive {
 	return v.flex
 }
 
+// SetHost sets the host used to show dialogs and switch views
+func (v *MonitorView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// SetSampleHandler sets the function called with every INFO sample of the refresh loop
+func (v *MonitorView) SetSampleHandler(fn func(info map[string]interface{})) {
+	v.sampleMu.Lock()
+	defer v.sampleMu.Unlock()
+	v.onSample = fn
+}
+
 // handleInput handles input for the monitor view
 func (v *MonitorView) handleInput(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Rune() {
@@ -145,6 +205,39 @@ func (v *MonitorView) handleInput(event *tcell.EventKey) *tcell.EventKey {
 		// Change refresh delay like in htop
 		v.cycleRefreshRate()
 		return nil
+	case 'm', 'M':
+		v.host.switchView(StreamViewType)
+		return nil
+	case 'l', 'L':
+		v.host.switchView(ClientsViewType)
+		return nil
+	case 'x', 'X':
+		if readOnlyBlocked(v.host, v.redis) {
+			return nil
+		}
+		v.host.confirm("Reset INFO statistics with CONFIG RESETSTAT?\nCommand stats, error stats, keyspace hits/misses and the slow log counters start again from zero.", v.resetStats)
+		return nil
+	case 'a', 'A':
+		v.host.switchView(AlertsViewType)
+		return nil
+	case 'e', 'E':
+		v.toggleErrors()
+		return nil
+	case 'g', 'G':
+		v.toggleChart()
+		return nil
+	case 'n', 'N':
+		v.chartMetric = (v.chartMetric + 1) % metricCount
+		v.renderTrends()
+		return nil
+	case 'w', 'W':
+		if v.window == 5*time.Minute {
+			v.window = time.Hour
+		} else {
+			v.window = 5 * time.Minute
+		}
+		v.renderTrends()
+		return nil
 	}
 
 	// Let all other keys pass through to global handler (including 1-6, ?, etc.)
@@ -263,7 +356,11 @@ func (v *MonitorView) clearScreen() {
 
 // loadData loads and displays all monitoring data
 func (v *MonitorView) loadData() {
+	v.sampleMu.Lock()
+	defer v.sampleMu.Unlock()
+
 	v.loadCommandStats()
+	v.loadErrorStats()
 	v.loadClientConnections()
 	v.loadServerStats()
 	v.loadSystemInfo()
@@ -279,46 +376,147 @@ func (v *MonitorView) loadCommandStats() {
 		return
 	}
 
+	now := time.Now()
+	rates := commandRates(v.prevCommands, stats, now.Sub(v.prevCommandsTime))
+	v.prevCommands = make(map[string]redis.CommandStat, len(stats))
+	for _, stat := range stats {
+		v.prevCommands[stat.Command] = stat
+	}
+	v.prevCommandsTime = now
+	v.commandStats, v.commandRates = stats, rates
+
 	// Clear existing data rows (keep header)
 	for row := v.commandTable.GetRowCount() - 1; row > 0; row-- {
 		v.commandTable.RemoveRow(row)
 	}
 
-	// Sort stats by total duration (descending)
-	// Simple bubble sort for small datasets
-	for i := 0; i < len(stats)-1; i++ {
-		for j := 0; j < len(stats)-i-1; j++ {
-			if stats[j].TotalDuration < stats[j+1].TotalDuration {
-				stats[j], stats[j+1] = stats[j+1], stats[j]
+	// Sort by calls/sec of the last interval once there is one, by total duration before that
+	sort.SliceStable(stats, func(i, j int) bool {
+		if rates != nil {
+			a, b := rates[stats[i].Command], rates[stats[j].Command]
+			if a.callsPerSec != b.callsPerSec {
+				return a.callsPerSec > b.callsPerSec
 			}
 		}
-	}
+		return stats[i].TotalDuration > stats[j].TotalDuration
+	})
+	v.setCommandHeaders(rates != nil)
 
 	// Add data rows
 	for i, stat := range stats {
 		row := i + 1
-		
+
 		// Command name
 		v.commandTable.SetCell(row, 0, tview.NewTableCell(stat.Command))
-		
-		// Number of calls
-		v.commandTable.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%.0f", float64(stat.Calls))))
-		
-		// Total duration
-		v.commandTable.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%.1f ms", stat.TotalDuration)))
-		
-		// Duration per call
-		v.commandTable.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("%.1f ms", stat.DurationPerCall)))
-		
-		// Rejected calls
-		v.commandTable.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%d", stat.RejectedCalls)))
-		
-		// Failed calls
-		v.commandTable.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("%d", stat.FailedCalls)))
-		
-		// CallsMaster (not available in standard Redis, show 0)
-		v.commandTable.SetCell(row, 6, tview.NewTableCell("0"))
+
+		// Activity during the last interval
+		callsPerSec, usecPerCall := "-", "-"
+		if rate, ok := rates[stat.Command]; ok {
+			callsPerSec = formatRate(rate.callsPerSec)
+			if rate.callsPerSec > 0 {
+				usecPerCall = fmt.Sprintf("%.2f", rate.usecPerCall)
+			}
+		}
+		v.commandTable.SetCell(row, 1, tview.NewTableCell(callsPerSec).SetAlign(tview.AlignRight).SetTextColor(tcell.ColorAqua))
+		v.commandTable.SetCell(row, 2, tview.NewTableCell(usecPerCall).SetAlign(tview.AlignRight).SetTextColor(tcell.ColorAqua))
+
+		// Cumulative totals
+		v.commandTable.SetCell(row, 3, tview.NewTableCell(fmt.Sprintf("%d", stat.Calls)).SetAlign(tview.AlignRight))
+		v.commandTable.SetCell(row, 4, tview.NewTableCell(fmt.Sprintf("%.1f ms", stat.TotalDuration)).SetAlign(tview.AlignRight))
+		v.commandTable.SetCell(row, 5, tview.NewTableCell(fmt.Sprintf("%.3f ms", stat.DurationPerCall)).SetAlign(tview.AlignRight))
+
+		// Latency percentiles from INFO latencystats
+		p50, p99, p999 := "-", "-", "-"
+		if stat.HasPercentiles {
+			p50 = fmt.Sprintf("%.1f µs", stat.P50)
+			p99 = fmt.Sprintf("%.1f µs", stat.P99)
+			p999 = fmt.Sprintf("%.1f µs", stat.P999)
+		}
+		v.commandTable.SetCell(row, 6, tview.NewTableCell(p50).SetAlign(tview.AlignRight))
+		v.commandTable.SetCell(row, 7, tview.NewTableCell(p99).SetAlign(tview.AlignRight))
+		v.commandTable.SetCell(row, 8, tview.NewTableCell(p999).SetAlign(tview.AlignRight))
+
+		// Rejected and failed calls
+		v.commandTable.SetCell(row, 9, tview.NewTableCell(fmt.Sprintf("%d", stat.RejectedCalls)).SetAlign(tview.AlignRight))
+		v.commandTable.SetCell(row, 10, tview.NewTableCell(fmt.Sprintf("%d", stat.FailedCalls)).SetAlign(tview.AlignRight))
+	}
+}
+
+// setCommandHeaders sets the command table headers, marking the sort column
+func (v *MonitorView) setCommandHeaders(byRate bool) {
+	headers := []string{"Command", "Calls/sec", "usec/call", "Calls", "Total Duration", "Duration per call", "p50", "p99", "p99.9", "Rejected", "Failed"}
+	if byRate {
+		headers[1] += " ↓"
+	} else {
+		headers[4] += " ↓"
+	}
+	for i, header := range headers {
+		cell := tview.NewTableCell(header).
+			SetTextColor(tcell.ColorYellow).
+			SetAlign(tview.AlignLeft).
+			SetSelectable(false)
+		v.commandTable.SetCell(0, i, cell)
+	}
+}
+
+// commandRates computes calls/sec and usec/call per command between two samples; nil without a previous sample
+func commandRates(prev map[string]redis.CommandStat, cur []redis.CommandStat, elapsed time.Duration) map[string]commandRate {
+	if prev == nil || elapsed <= 0 {
+		return nil
+	}
+
+	rates := make(map[string]commandRate, len(cur))
+	for _, stat := range cur {
+		old := prev[stat.Command] // Commands first called during the interval start from zero
+		calls := stat.Calls - old.Calls
+		usec := (stat.TotalDuration - old.TotalDuration) * 1000
+		if calls < 0 || usec < 0 {
+			// Counters went backwards after CONFIG RESETSTAT or a restart
+			calls, usec = stat.Calls, stat.TotalDuration*1000
+		}
+
+		failed := stat.FailedCalls - old.FailedCalls
+		rejected := stat.RejectedCalls - old.RejectedCalls
+		if failed < 0 || rejected < 0 {
+			failed, rejected = stat.FailedCalls, stat.RejectedCalls
+		}
+
+		rate := commandRate{
+			callsPerSec:    float64(calls) / elapsed.Seconds(),
+			failedPerSec:   float64(failed) / elapsed.Seconds(),
+			rejectedPerSec: float64(rejected) / elapsed.Seconds(),
+		}
+		if calls > 0 {
+			rate.usecPerCall = usec / float64(calls)
+		}
+		rates[stat.Command] = rate
 	}
+	return rates
+}
+
+// ResetHistory forgets the metric history and counters, e.g. after connecting to another server
+func (v *MonitorView) ResetHistory() {
+	v.sampleMu.Lock()
+	defer v.sampleMu.Unlock()
+
+	v.history = NewMetricHistory(metricHistorySize)
+	v.errors = newErrorTracker()
+	v.errorRates = nil
+	v.prevCommands = nil
+	v.lastMetrics = nil
+}
+
+// resetStats runs CONFIG RESETSTAT and starts the per-interval rates over
+func (v *MonitorView) resetStats() {
+	if err := v.redis.ResetStats(); err != nil {
+		v.host.showMessage(err.Error())
+		return
+	}
+	logger.Info("[MonitorView] Server statistics reset")
+	v.sampleMu.Lock()
+	v.prevCommands = nil
+	v.sampleMu.Unlock()
+	v.Refresh()
 }
 
 // loadClientConnections loads client connection information into the table
@@ -385,31 +583,216 @@ func (v *MonitorView) loadServerStats() {
 		return
 	}
 
+	v.lastMetrics = metrics
+	v.history.Add(metrics, time.Now())
+	v.renderTrends()
+}
+
+// renderTrends redraws the stats table with sparklines, or the chart or errors shown instead
+func (v *MonitorView) renderTrends() {
+	switch name, _ := v.bottomPages.GetFrontPage(); name {
+	case "chart":
+		v.renderChart()
+		return
+	case "errors":
+		v.renderErrors()
+		return
+	}
+
+	// New error types are flagged on the stats table so they are noticed without opening the panel
+	title := "Server Statistics"
+	if newErrors := v.newErrorTypes(); len(newErrors) > 0 {
+		title += fmt.Sprintf(" [red]| NEW ERRORS: %s (press e)[-]", strings.Join(newErrors, ", "))
+	}
+	v.statsTable.SetTitle(title)
+
+	metrics := v.lastMetrics
+	if metrics == nil {
+		return
+	}
 	v.statsTable.Clear()
-	
+
 	// Calculate hit rate
 	hitRate := float64(0)
 	if metrics.KeyspaceHits+metrics.KeyspaceMisses > 0 {
 		hitRate = float64(metrics.KeyspaceHits) / float64(metrics.KeyspaceHits+metrics.KeyspaceMisses) * 100
 	}
 
-	// Server statistics table
-	stats := [][]string{
-		{"Connected Clients", fmt.Sprintf("%d", metrics.ConnectedClients)},
-		{"Used Memory", humanize.Bytes(uint64(metrics.UsedMemory))},
-		{"Used Memory RSS", humanize.Bytes(uint64(metrics.UsedMemoryRss))},
-		{"Total Commands", fmt.Sprintf("%d", metrics.TotalCommandsProcessed)},
-		{"Ops/sec", fmt.Sprintf("%d", metrics.InstantaneousOpsPerSec)},
-		{"Keyspace Hits", fmt.Sprintf("%d", metrics.KeyspaceHits)},
-		{"Keyspace Misses", fmt.Sprintf("%d", metrics.KeyspaceMisses)},
-		{"Hit Rate", fmt.Sprintf("%.2f%%", hitRate)},
-		{"Uptime", getFormattedUptime(metrics.UptimeInSeconds)},
+	// Server statistics table; rows with a series get a sparkline of the selected window
+	latest, hasLatest := v.history.Latest()
+	rate := func(metric int) string {
+		if !hasLatest {
+			return "-"
+		}
+		return metricSeriesDefs[metric].format(latest.values[metric])
+	}
+	opsPerSec := fmt.Sprintf("%d", metrics.InstantaneousOpsPerSec)
+	if hasLatest {
+		opsPerSec = rate(metricOpsPerSec)
+	}
+	stats := []struct {
+		label  string
+		value  string
+		metric int
+	}{
+		{"Connected Clients", fmt.Sprintf("%d", metrics.ConnectedClients), metricClients},
+		{"Used Memory", humanize.Bytes(uint64(metrics.UsedMemory)), metricUsedMemory},
+		{"Used Memory RSS", humanize.Bytes(uint64(metrics.UsedMemoryRss)), metricRSS},
+		{"Fragmentation", fmt.Sprintf("%.2f", metrics.MemFragmentationRatio), metricFragmentation},
+		{"Total Commands", fmt.Sprintf("%d", metrics.TotalCommandsProcessed), -1},
+		{"Ops/sec", opsPerSec, metricOpsPerSec},
+		{"Keyspace Hits", fmt.Sprintf("%d", metrics.KeyspaceHits), -1},
+		{"Keyspace Misses", fmt.Sprintf("%d", metrics.KeyspaceMisses), -1},
+		{"Hit Rate", fmt.Sprintf("%.2f%% (interval %s)", hitRate, rate(metricHitRate)), metricHitRate},
+		{"Net In", rate(metricNetInKbps), metricNetInKbps},
+		{"Net Out", rate(metricNetOutKbps), metricNetOutKbps},
+		{"Expired Keys/s", rate(metricExpiredPerSec), metricExpiredPerSec},
+		{"Evicted Keys/s", rate(metricEvictedPerSec), metricEvictedPerSec},
+		{"Uptime", getFormattedUptime(metrics.UptimeInSeconds), -1},
 	}
 
 	for i, stat := range stats {
-		v.statsTable.SetCell(i, 0, tview.NewTableCell(stat[0]).SetTextColor(tcell.ColorGreen))
-		v.statsTable.SetCell(i, 1, tview.NewTableCell(stat[1]).SetTextColor(tcell.ColorWhite))
+		v.statsTable.SetCell(i, 0, tview.NewTableCell(stat.label).SetTextColor(tcell.ColorGreen))
+		v.statsTable.SetCell(i, 1, tview.NewTableCell(stat.value).SetTextColor(tcell.ColorWhite))
+		if stat.metric >= 0 {
+			v.statsTable.SetCell(i, 2, tview.NewTableCell(sparkline(v.history.Series(stat.metric, v.window), 30)).SetTextColor(tcell.ColorAqua))
+		}
+	}
+}
+
+// toggleChart switches the bottom-left panel between the stats table and a trend chart
+func (v *MonitorView) toggleChart() {
+	v.toggleBottomPage("chart")
+}
+
+// toggleErrors switches the bottom-left panel between the stats table and the errors panel
+func (v *MonitorView) toggleErrors() {
+	v.toggleBottomPage("errors")
+}
+
+// toggleBottomPage shows page in the bottom-left panel, or the stats table if it is already shown
+func (v *MonitorView) toggleBottomPage(page string) {
+	if name, _ := v.bottomPages.GetFrontPage(); name == page {
+		v.bottomPages.SwitchToPage("stats")
+	} else {
+		v.bottomPages.SwitchToPage(page)
 	}
+	v.renderTrends()
+}
+
+// loadErrorStats samples INFO errorstats
+func (v *MonitorView) loadErrorStats() {
+	stats, err := v.redis.GetErrorStats()
+	v.errorsErr = err
+	if err != nil {
+		return
+	}
+	v.errorRates = v.errors.Update(stats, time.Now())
+}
+
+// newErrorTypes returns the error types that appeared within the last minute
+func (v *MonitorView) newErrorTypes() []string {
+	var types []string
+	for _, e := range v.errorRates {
+		if e.isNew {
+			types = append(types, e.errType)
+		}
+	}
+	return types
+}
+
+// renderErrors fills the errors panel with error types and the commands that failed or were rejected
+func (v *MonitorView) renderErrors() {
+	v.errorsTable.Clear()
+	v.errorsTable.SetTitle("Errors (INFO errorstats) | e: back to stats")
+
+	headers := []string{"Error", "Count", "/sec", "First seen"}
+	for i, header := range headers {
+		v.errorsTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetSelectable(false))
+	}
+
+	row := 1
+	if v.errorsErr != nil {
+		v.errorsTable.SetCell(row, 0, tview.NewTableCell("ERROR").SetTextColor(tcell.ColorRed))
+		v.errorsTable.SetCell(row, 1, tview.NewTableCell(v.errorsErr.Error()).SetTextColor(tcell.ColorRed))
+		row++
+	} else if len(v.errorRates) == 0 {
+		v.errorsTable.SetCell(row, 0, tview.NewTableCell("No errors reported (needs Redis 6.2+ or Valkey)").SetTextColor(tcell.ColorGray))
+		row++
+	}
+
+	for _, e := range v.errorRates {
+		color := tcell.ColorWhite
+		name := e.errType
+		if e.perSec > 0 {
+			color = tcell.ColorOrange
+		}
+		if e.isNew {
+			color = tcell.ColorRed
+			name += " NEW"
+		}
+		v.errorsTable.SetCell(row, 0, tview.NewTableCell(name).SetTextColor(color))
+		v.errorsTable.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d", e.count)).SetAlign(tview.AlignRight))
+		v.errorsTable.SetCell(row, 2, tview.NewTableCell(formatRate(e.perSec)).SetAlign(tview.AlignRight).SetTextColor(color))
+		v.errorsTable.SetCell(row, 3, tview.NewTableCell(e.firstSeen.Format("15:04:05")))
+		row++
+	}
+
+	// Commands with failed or rejected calls, busiest first
+	stats := make([]redis.CommandStat, 0, len(v.commandStats))
+	for _, stat := range v.commandStats {
+		if stat.FailedCalls > 0 || stat.RejectedCalls > 0 {
+			stats = append(stats, stat)
+		}
+	}
+	if len(stats) == 0 {
+		return
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		a, b := v.commandRates[stats[i].Command], v.commandRates[stats[j].Command]
+		if a.failedPerSec+a.rejectedPerSec != b.failedPerSec+b.rejectedPerSec {
+			return a.failedPerSec+a.rejectedPerSec > b.failedPerSec+b.rejectedPerSec
+		}
+		return stats[i].FailedCalls+stats[i].RejectedCalls > stats[j].FailedCalls+stats[j].RejectedCalls
+	})
+
+	row++
+	for i, header := range []string{"Command", "Failed (/sec)", "Rejected (/sec)"} {
+		v.errorsTable.SetCell(row, i, tview.NewTableCell(header).SetTextColor(tcell.ColorYellow))
+	}
+	row++
+	for _, stat := range stats {
+		rate := v.commandRates[stat.Command]
+		v.errorsTable.SetCell(row, 0, tview.NewTableCell(stat.Command))
+		v.errorsTable.SetCell(row, 1, tview.NewTableCell(fmt.Sprintf("%d (%s)", stat.FailedCalls, formatRate(rate.failedPerSec))).SetAlign(tview.AlignRight))
+		v.errorsTable.SetCell(row, 2, tview.NewTableCell(fmt.Sprintf("%d (%s)", stat.RejectedCalls, formatRate(rate.rejectedPerSec))).SetAlign(tview.AlignRight))
+		row++
+	}
+}
+
+// renderChart draws the selected metric over the selected window
+func (v *MonitorView) renderChart() {
+	series := metricSeriesDefs[v.chartMetric]
+	values := v.history.Series(v.chartMetric, v.window)
+
+	_, _, width, height := v.chartView.GetInnerRect()
+	if width <= 0 {
+		width, height = 60, 10
+	}
+
+	v.chartView.SetTitle(fmt.Sprintf("%s - last %s (n: next metric, w: 5m/1h, g: table)", series.name, formatWindow(v.window)))
+	v.chartView.SetText(lineChart(values, width, height, series.format))
+}
+
+// formatWindow formats a chart window as 5m or 1h
+func formatWindow(d time.Duration) string {
+	if d >= time.Hour {
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	}
+	return fmt.Sprintf("%dm", int(d.Minutes()))
 }
 
 // loadSystemInfo loads system information
@@ -419,6 +802,9 @@ func (v *MonitorView) loadSystemInfo() {
 		v.infoText.SetText(fmt.Sprintf("[red]Error loading Redis info: %s", err))
 		return
 	}
+	if v.onSample != nil {
+		v.onSample(info)
+	}
 
 	timestamp := time.Now().Format("15:04:05")
 	
@@ -435,7 +821,7 @@ func (v *MonitorView) loadSystemInfo() {
   [green]Rejected Connections:[white] %s
 
 [cyan]━━━ Performance ━━━[white]
-  [green]Slow Log Length:[white] %s
+  [green]Slow Log Length:[white] %s (press 9 for the Slow Log view)
 
 [cyan]━━━ Cluster Info ━━━[white]
   [green]Cluster Enabled:[white] %s
@@ -460,6 +846,12 @@ func (v *MonitorView) loadSystemInfo() {
   [green]d/D:[white] Change refresh rate (%.0fs)
   [green]c/C:[white] Clear all tables
   [green]r/R:[white] Manual refresh
+  [green]m/M:[white] Live MONITOR stream
+  [green]l/L:[white] Manage clients
+  [green]x/X:[white] CONFIG RESETSTAT
+  [green]e/E:[white] Error statistics
+  [green]a/A:[white] Alert rules and history
+  [green]g/G:[white] Toggle trend chart (n: next metric, w: 5m/1h window)
   [green]?:[white] Help
 `, v.refreshRate.Seconds())
 
@@ -0,0 +1,545 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// maxPubSubMessages bounds the message log kept in memory
+const maxPubSubMessages = 1000
+
+// subscriptionKind is what a subscribed name refers to
+type subscriptionKind int
+
+// Subscription kinds
+const (
+	kindChannel subscriptionKind = iota // SUBSCRIBE
+	kindPattern                         // PSUBSCRIBE
+	kindShard                           // SSUBSCRIBE, for SPUBLISH messages (Redis 7+)
+)
+
+// String names the kind in log notices
+func (k subscriptionKind) String() string {
+	switch k {
+	case kindPattern:
+		return "pattern"
+	case kindShard:
+		return "shard channel"
+	}
+	return "channel"
+}
+
+// PubSubView lists channels, subscribes to channels and patterns and publishes messages
+type PubSubView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex          *tview.Flex
+	channelsTable *tview.Table
+	filter        *tview.InputField
+	messageLog    *tview.TextView
+
+	// State
+	channels     []redis.ChannelInfo
+	subscription *redis.Subscription
+	mu           sync.Mutex
+	messages     []redis.PubSubMessage
+	filterText   string
+	dirty        bool
+}
+
+// NewPubSubView creates a new Pub/Sub view
+func NewPubSubView(redisClient *redis.Client) *PubSubView {
+	view := &PubSubView{
+		redis: redisClient,
+	}
+
+	view.setupUI()
+	view.loadChannels()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *PubSubView) setupUI() {
+	v.channelsTable = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.channelsTable.SetBorder(true).
+		SetTitle("Channels").
+		SetTitleAlign(tview.AlignLeft)
+
+	// Enter subscribes to the selected channel, with SSUBSCRIBE for shard channels
+	v.channelsTable.SetSelectedFunc(func(row, col int) {
+		if row > 0 && row <= len(v.channels) {
+			ch := v.channels[row-1]
+			kind := kindChannel
+			if ch.Shard {
+				kind = kindShard
+			}
+			v.subscribe([]string{ch.Name}, kind)
+		}
+	})
+
+	v.filter = tview.NewInputField().
+		SetLabel("Filter: ").
+		SetFieldWidth(0).
+		SetChangedFunc(func(text string) {
+			v.mu.Lock()
+			v.filterText = strings.ToLower(text)
+			v.mu.Unlock()
+			v.renderMessages()
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			v.host.setFocus(v.messageLog)
+		})
+
+	v.messageLog = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(true)
+	v.messageLog.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+	v.updateLogTitle()
+
+	right := tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.filter, 1, 0, false).
+		AddItem(v.messageLog, 0, 1, false)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]Enter[white] subscribe to channel  [yellow]s[white] subscribe (channels/patterns)  [yellow]u[white] unsubscribe  [yellow]U[white] unsubscribe all  [yellow]p[white] publish  [yellow]/[white] filter  [yellow]c[white] clear  [yellow]r[white] refresh  [yellow]Tab[white] switch pane")
+
+	body := tview.NewFlex().SetDirection(tview.FlexColumn).
+		AddItem(v.channelsTable, 0, 1, true).
+		AddItem(right, 0, 2, false)
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(body, 0, 1, true).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		// Leave typing in the filter alone
+		if v.filter.HasFocus() {
+			return event
+		}
+
+		switch event.Key() {
+		case tcell.KeyTab:
+			if v.channelsTable.HasFocus() {
+				v.host.setFocus(v.messageLog)
+			} else {
+				v.host.setFocus(v.channelsTable)
+			}
+			return nil
+		case tcell.KeyRune:
+			switch event.Rune() {
+			case 's', 'S':
+				v.showSubscribeDialog()
+				return nil
+			case 'u':
+				v.showUnsubscribeDialog()
+				return nil
+			case 'U':
+				v.unsubscribeAll()
+				return nil
+			case 'p', 'P':
+				v.showPublishDialog()
+				return nil
+			case '/':
+				v.host.setFocus(v.filter)
+				return nil
+			case 'c', 'C':
+				v.clearMessages()
+				return nil
+			case 'r', 'R':
+				v.Refresh()
+				return nil
+			}
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *PubSubView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *PubSubView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// loadChannels refreshes the channel table from PUBSUB CHANNELS/NUMSUB/NUMPAT
+func (v *PubSubView) loadChannels() {
+	v.channelsTable.Clear()
+	headers := []string{"Channel", "Subscribers"}
+	for i, header := range headers {
+		v.channelsTable.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	overview, err := v.redis.GetPubSubOverview("*")
+	if err != nil {
+		v.channelsTable.SetCell(1, 0, tview.NewTableCell("ERROR").SetTextColor(tcell.ColorRed))
+		v.channelsTable.SetCell(1, 1, tview.NewTableCell(err.Error()).SetTextColor(tcell.ColorRed))
+		return
+	}
+
+	v.channels = overview.Channels
+	for i, ch := range v.channels {
+		name := ch.Name
+		if ch.Shard {
+			name += " [shard]"
+		}
+		v.channelsTable.SetCell(i+1, 0, tview.NewTableCell(tview.Escape(name)))
+		v.channelsTable.SetCell(i+1, 1, tview.NewTableCell(fmt.Sprintf("%d", ch.Subscribers)).SetAlign(tview.AlignRight))
+	}
+
+	title := fmt.Sprintf("Channels (%d) | Patterns: %d", len(v.channels), overview.PatternCount)
+	if !overview.ShardsEnabled {
+		title += " | no sharded Pub/Sub"
+	}
+	v.channelsTable.SetTitle(title)
+}
+
+// showSubscribeDialog asks for channels or patterns to subscribe to
+func (v *PubSubView) showSubscribeDialog() {
+	const name = "subscribe"
+
+	names := ""
+	kind := kindChannel
+	if row, _ := v.channelsTable.GetSelection(); row > 0 && row <= len(v.channels) {
+		names = v.channels[row-1].Name
+		if v.channels[row-1].Shard {
+			kind = kindShard
+		}
+	}
+
+	form := v.host.newDialogForm(name, "Subscribe")
+	kinds := []string{"Channels (SUBSCRIBE)", "Patterns (PSUBSCRIBE)", "Shard channels (SSUBSCRIBE)"}
+	form.AddDropDown("Subscribe to", kinds, int(kind), func(option string, index int) {
+		kind = subscriptionKind(index)
+	})
+	form.AddInputField("Names", names, 50, nil, func(text string) {
+		names = text
+	})
+	form.AddTextView("", "Separate names with spaces; channel names are matched exactly, patterns use * ? [ ] globs", 50, 2, true, false)
+	form.AddButton("Subscribe", func() {
+		v.host.closeDialog(name)
+		v.subscribe(strings.Fields(names), kind)
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 72, 12)
+}
+
+// showUnsubscribeDialog lists the subscriptions so one or all of them can be dropped
+func (v *PubSubView) showUnsubscribeDialog() {
+	if v.subscription == nil {
+		v.host.showMessage("Not subscribed to any channel or pattern")
+		return
+	}
+	const name = "unsubscribe"
+
+	list := tview.NewList().ShowSecondaryText(false)
+	list.SetBorder(true).
+		SetTitle(" Unsubscribe ").
+		SetTitleAlign(tview.AlignLeft)
+
+	channels, patterns, shards := v.subscription.Subscriptions()
+	add := func(names []string, kind subscriptionKind) {
+		for _, n := range names {
+			n := n
+			list.AddItem(fmt.Sprintf("%-14s %s", kind, tview.Escape(n)), "", 0, func() {
+				v.host.closeDialog(name)
+				v.unsubscribe(n, kind)
+			})
+		}
+	}
+	add(channels, kindChannel)
+	add(patterns, kindPattern)
+	add(shards, kindShard)
+	list.AddItem("All channels, patterns and shard channels", "", 'a', func() {
+		v.host.closeDialog(name)
+		v.unsubscribeAll()
+	})
+	list.SetDoneFunc(func() {
+		v.host.closeDialog(name)
+	})
+
+	height := list.GetItemCount() + 2
+	if height > 20 {
+		height = 20
+	}
+	v.host.showDialog(name, list, 60, height)
+}
+
+// showPublishDialog asks for a channel and message to publish
+func (v *PubSubView) showPublishDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "publish"
+
+	channel := ""
+	shard := false
+	if row, _ := v.channelsTable.GetSelection(); row > 0 && row <= len(v.channels) {
+		channel = v.channels[row-1].Name
+		shard = v.channels[row-1].Shard
+	}
+	message := ""
+
+	form := v.host.newDialogForm(name, "Publish")
+	form.AddInputField("Channel", channel, 50, nil, func(text string) {
+		channel = text
+	})
+	form.AddInputField("Message", "", 50, nil, func(text string) {
+		message = text
+	})
+	form.AddCheckbox("Shard channel (SPUBLISH)", shard, func(checked bool) {
+		shard = checked
+	})
+	form.AddButton("Publish", func() {
+		v.host.closeDialog(name)
+		publish, cmd := v.redis.Publish, "PUBLISH"
+		if shard {
+			publish, cmd = v.redis.SPublish, "SPUBLISH"
+		}
+
+		go func() {
+			receivers, err := publish(channel, message)
+			v.host.queueUpdate(func() {
+				if err != nil {
+					v.host.showMessage(fmt.Sprintf("%s failed: %v", cmd, err))
+					return
+				}
+				v.appendNotice(fmt.Sprintf("published to %s, %d receivers", channel, receivers))
+				v.loadChannels()
+			})
+		}()
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 72, 11)
+}
+
+// subscribe adds subscriptions of one kind in the background, opening the dedicated
+// connection on first use
+func (v *PubSubView) subscribe(names []string, kind subscriptionKind) {
+	if len(names) == 0 {
+		return
+	}
+
+	if v.subscription == nil {
+		v.subscription = v.redis.NewSubscription()
+		go v.receive(v.subscription)
+	}
+	sub := v.subscription
+
+	subscribe := sub.Subscribe
+	switch kind {
+	case kindPattern:
+		subscribe = sub.PSubscribe
+	case kindShard:
+		subscribe = sub.SSubscribe
+	}
+
+	go func() {
+		err := subscribe(names...)
+		v.host.queueUpdate(func() {
+			if err != nil {
+				v.host.showMessage(err.Error())
+				return
+			}
+			logger.Infof("[PubSubView] Subscribed to %s %v", kind, names)
+			v.appendNotice(fmt.Sprintf("subscribed to %s %s", kind, strings.Join(names, " ")))
+			v.updateLogTitle()
+			v.loadChannels()
+		})
+	}()
+}
+
+// unsubscribe drops one subscription in the background, closing the connection when none are left
+func (v *PubSubView) unsubscribe(name string, kind subscriptionKind) {
+	if v.subscription == nil {
+		return
+	}
+	sub := v.subscription
+
+	unsubscribe := sub.Unsubscribe
+	switch kind {
+	case kindPattern:
+		unsubscribe = sub.PUnsubscribe
+	case kindShard:
+		unsubscribe = sub.SUnsubscribe
+	}
+
+	go func() {
+		err := unsubscribe(name)
+		v.host.queueUpdate(func() {
+			if err != nil {
+				v.host.showMessage(err.Error())
+				return
+			}
+			// The connection may have been replaced by unsubscribing from everything meanwhile
+			if v.subscription == sub {
+				if channels, patterns, shards := sub.Subscriptions(); len(channels)+len(patterns)+len(shards) == 0 {
+					sub.Close()
+					v.subscription = nil
+				}
+			}
+			v.appendNotice(fmt.Sprintf("unsubscribed from %s %s", kind, name))
+			v.updateLogTitle()
+			v.loadChannels()
+		})
+	}()
+}
+
+// unsubscribeAll closes the dedicated connection
+func (v *PubSubView) unsubscribeAll() {
+	if v.subscription == nil {
+		return
+	}
+
+	v.subscription.Close()
+	v.subscription = nil
+	v.appendNotice("unsubscribed from all channels, patterns and shard channels")
+	v.updateLogTitle()
+	v.loadChannels()
+}
+
+// receive collects messages until the subscription closes, redrawing at most every 200ms
+func (v *PubSubView) receive(sub *redis.Subscription) {
+	ticker := time.NewTicker(200 * time.Millisecond)
+	defer ticker.Stop()
+
+	messages := sub.Messages()
+	for {
+		select {
+		case msg, ok := <-messages:
+			if !ok {
+				return
+			}
+			v.mu.Lock()
+			v.messages = append(v.messages, msg)
+			if len(v.messages) > maxPubSubMessages {
+				v.messages = v.messages[len(v.messages)-maxPubSubMessages:]
+			}
+			v.dirty = true
+			v.mu.Unlock()
+		case <-ticker.C:
+			v.mu.Lock()
+			dirty := v.dirty
+			v.dirty = false
+			v.mu.Unlock()
+			if dirty {
+				v.host.queueUpdate(v.renderMessages)
+			}
+		}
+	}
+}
+
+// appendNotice adds a local status line to the log
+func (v *PubSubView) appendNotice(text string) {
+	v.mu.Lock()
+	v.messages = append(v.messages, redis.PubSubMessage{Time: time.Now(), Payload: text})
+	v.mu.Unlock()
+	v.renderMessages()
+}
+
+// clearMessages empties the message log
+func (v *PubSubView) clearMessages() {
+	v.mu.Lock()
+	v.messages = nil
+	v.mu.Unlock()
+	v.renderMessages()
+}
+
+// renderMessages writes the filtered log into the text view
+func (v *PubSubView) renderMessages() {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	var b strings.Builder
+	shown := 0
+	for _, msg := range v.messages {
+		if v.filterText != "" &&
+			!strings.Contains(strings.ToLower(msg.Channel), v.filterText) &&
+			!strings.Contains(strings.ToLower(msg.Payload), v.filterText) {
+			continue
+		}
+		shown++
+
+		b.WriteString(fmt.Sprintf("[gray]%s[white] ", msg.Time.Format("15:04:05.000")))
+		if msg.Channel == "" {
+			// Local notice rather than a received message
+			b.WriteString(fmt.Sprintf("[yellow]-- %s --[white]\n", tview.Escape(msg.Payload)))
+			continue
+		}
+		b.WriteString(fmt.Sprintf("[green]%s[white]", tview.Escape(msg.Channel)))
+		if msg.Pattern != "" {
+			b.WriteString(fmt.Sprintf(" [cyan](%s)[white]", tview.Escape(msg.Pattern)))
+		}
+		b.WriteString(" " + tview.Escape(msg.Payload) + "\n")
+	}
+
+	v.messageLog.SetText(b.String())
+	v.messageLog.ScrollToEnd()
+	if v.filterText != "" {
+		v.messageLog.SetTitle(fmt.Sprintf("%s [%d of %d shown]", v.logTitle(), shown, len(v.messages)))
+	} else {
+		v.messageLog.SetTitle(v.logTitle())
+	}
+}
+
+// updateLogTitle shows the current subscriptions in the log title
+func (v *PubSubView) updateLogTitle() {
+	v.messageLog.SetTitle(v.logTitle())
+}
+
+// logTitle returns the message log title for the current subscriptions
+func (v *PubSubView) logTitle() string {
+	if v.subscription == nil {
+		return "Messages [not subscribed]"
+	}
+	channels, patterns, shards := v.subscription.Subscriptions()
+	subscribed := strings.Join(channels, " ")
+	if len(patterns) > 0 {
+		subscribed = strings.TrimSpace(subscribed + " patterns: " + strings.Join(patterns, " "))
+	}
+	if len(shards) > 0 {
+		subscribed = strings.TrimSpace(subscribed + " shards: " + strings.Join(shards, " "))
+	}
+	return fmt.Sprintf("Messages [subscribed: %s]", tview.Escape(subscribed))
+}
+
+// Refresh reloads the channel list
+func (v *PubSubView) Refresh() {
+	v.loadChannels()
+}
+
+// Close drops any active subscription
+func (v *PubSubView) Close() {
+	if v.subscription != nil {
+		v.subscription.Close()
+		v.subscription = nil
+	}
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/rivo/tview"
+)
+
+// formatReply renders a command reply like redis-cli: every RESP2 and RESP3 type is
+// labelled, and nested arrays and maps are numbered and indented
+func formatReply(reply interface{}) string {
+	return renderReply(reply, 0, true)
+}
+
+// formatResult renders a reply for a tview text view, coloured by its type
+func formatResult(result interface{}) string {
+	color := "yellow"
+	switch result.(type) {
+	case nil:
+		color = "gray"
+	case int64, float64, bool, *big.Int, []interface{}, map[interface{}]interface{}:
+		color = "cyan"
+	}
+	return fmt.Sprintf("[%s]%s[white]", color, tview.Escape(formatReply(result)))
+}
+
+// renderReply renders a reply whose first line starts at column indent; top-level
+// strings are shown as they are so multi-line replies like INFO stay readable
+func renderReply(reply interface{}, indent int, top bool) string {
+	switch r := reply.(type) {
+	case nil:
+		return "(nil)"
+	case error:
+		return "(error) " + r.Error()
+	case string:
+		if top && r != "" {
+			return r
+		}
+		return redis.QuoteArg(r)
+	case int64:
+		return fmt.Sprintf("(integer) %d", r)
+	case float64:
+		return "(double) " + formatDouble(r)
+	case bool:
+		if r {
+			return "(true)"
+		}
+		return "(false)"
+	case *big.Int:
+		return "(big number) " + r.String()
+	case []interface{}:
+		return renderArray(r, indent)
+	case map[interface{}]interface{}:
+		return renderMap(r, indent)
+	case map[string]interface{}:
+		m := make(map[interface{}]interface{}, len(r))
+		for k, v := range r {
+			m[k] = v
+		}
+		return renderMap(m, indent)
+	}
+	return fmt.Sprint(reply)
+}
+
+// renderArray numbers the items of an array, set or push reply
+func renderArray(items []interface{}, indent int) string {
+	if len(items) == 0 {
+		return "(empty array)"
+	}
+
+	width := len(strconv.Itoa(len(items)))
+	lines := make([]string, len(items))
+	for i, item := range items {
+		prefix := fmt.Sprintf("%*d) ", width, i+1)
+		lines[i] = prefix + renderReply(item, indent+len(prefix), false)
+	}
+	return strings.Join(lines, "\n"+strings.Repeat(" ", indent))
+}
+
+// renderMap numbers the fields of a map reply as "1# key => value", sorted by key
+func renderMap(m map[interface{}]interface{}, indent int) string {
+	if len(m) == 0 {
+		return "(empty hash)"
+	}
+
+	type field struct {
+		key   string
+		value interface{}
+	}
+	fields := make([]field, 0, len(m))
+	for k, v := range m {
+		fields = append(fields, field{renderReply(k, 0, false), v})
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].key < fields[j].key })
+
+	width := len(strconv.Itoa(len(fields)))
+	lines := make([]string, len(fields))
+	for i, f := range fields {
+		head := fmt.Sprintf("%*d# %s => ", width, i+1, f.key)
+		lines[i] = head + renderReply(f.value, indent+len(head), false)
+	}
+	return strings.Join(lines, "\n"+strings.Repeat(" ", indent))
+}
+
+// formatDouble formats a RESP3 double as the server sends it
+func formatDouble(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "inf"
+	case math.IsInf(f, -1):
+		return "-inf"
+	case math.IsNaN(f):
+		return "nan"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
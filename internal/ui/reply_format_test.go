@@ -0,0 +1,56 @@
+package ui
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestFormatReply tests rendering every reply type with redis-cli style nesting
+func TestFormatReply(t *testing.T) {
+	assert.Equal(t, "OK", formatReply("OK"))
+	assert.Equal(t, `""`, formatReply(""))
+	assert.Equal(t, "(nil)", formatReply(nil))
+	assert.Equal(t, "(integer) 42", formatReply(int64(42)))
+	assert.Equal(t, "(double) 3.14", formatReply(3.14))
+	assert.Equal(t, "(double) inf", formatReply(math.Inf(1)))
+	assert.Equal(t, "(true)", formatReply(true))
+	bigNumber, _ := new(big.Int).SetString("1234567890123456789012", 10)
+	assert.Equal(t, "(big number) 1234567890123456789012", formatReply(bigNumber))
+	assert.Equal(t, "(empty array)", formatReply([]interface{}{}))
+	assert.Equal(t, "(empty hash)", formatReply(map[interface{}]interface{}{}))
+
+	assert.Equal(t, "1) \"a b\"\n2) (integer) 1\n3) (nil)\n4) (error) ERR wrong",
+		formatReply([]interface{}{"a b", int64(1), nil, errors.New("ERR wrong")}))
+
+	// Nested replies line up under their parent's numbering
+	nested := []interface{}{
+		"first",
+		[]interface{}{"x", []interface{}{"y", "z"}},
+	}
+	assert.Equal(t, "1) \"first\"\n"+
+		"2) 1) \"x\"\n"+
+		"   2) 1) \"y\"\n"+
+		"      2) \"z\"", formatReply(nested))
+
+	// RESP3 maps, e.g. CLIENT TRACKINGINFO
+	tracking := map[interface{}]interface{}{
+		"flags":    []interface{}{"off"},
+		"redirect": int64(-1),
+		"prefixes": []interface{}{},
+	}
+	assert.Equal(t, "1# \"flags\" => 1) \"off\"\n"+
+		"2# \"prefixes\" => (empty array)\n"+
+		"3# \"redirect\" => (integer) -1", formatReply(tracking))
+
+	// Ten or more items pad the numbering
+	items := make([]interface{}, 10)
+	for i := range items {
+		items[i] = int64(i)
+	}
+	assert.Equal(t, " 1) (integer) 0", formatReply(items)[:15])
+	assert.Contains(t, formatReply(items), "\n10) (integer) 9")
+}
@@ -0,0 +1,278 @@
+package ui
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/logger"
+	"github.com/mohan-s-gopal/redis-valkey-tui/internal/redis"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// slowLogFetchCount is how many entries SLOWLOG GET asks for when slowlog-max-len is unknown
+const slowLogFetchCount = 128
+
+// SlowLogView shows SLOWLOG entries with their full arguments
+type SlowLogView struct {
+	redis *redis.Client
+	host  *viewHost
+
+	// Components
+	flex    *tview.Flex
+	filter  *tview.InputField
+	table   *tview.Table
+	details *tview.TextView
+
+	// State
+	entries    []redis.SlowLogEntry
+	shown      []redis.SlowLogEntry
+	byDuration bool
+	threshold  string
+	maxLen     string
+}
+
+// NewSlowLogView creates a new slow log view
+func NewSlowLogView(redisClient *redis.Client) *SlowLogView {
+	view := &SlowLogView{
+		redis:      redisClient,
+		byDuration: true,
+	}
+
+	view.setupUI()
+	view.Refresh()
+
+	return view
+}
+
+// setupUI initializes the UI components
+func (v *SlowLogView) setupUI() {
+	v.filter = tview.NewInputField().
+		SetLabel("Command: ").
+		SetFieldWidth(30).
+		SetChangedFunc(func(text string) {
+			v.render()
+		}).
+		SetDoneFunc(func(key tcell.Key) {
+			v.host.setFocus(v.table)
+		})
+
+	v.table = tview.NewTable().
+		SetFixed(1, 0).
+		SetSelectable(true, false)
+	v.table.SetBorder(true).
+		SetTitleAlign(tview.AlignLeft)
+
+	v.table.SetSelectionChangedFunc(func(row, col int) {
+		if row > 0 && row <= len(v.shown) {
+			v.showDetails(v.shown[row-1])
+		}
+	})
+
+	v.details = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(true)
+	v.details.SetBorder(true).
+		SetTitle("Arguments").
+		SetTitleAlign(tview.AlignLeft)
+
+	help := tview.NewTextView().
+		SetDynamicColors(true).
+		SetText("[yellow]/[white] filter by command  [yellow]o[white] sort by duration/newest  [yellow]t[white] threshold  [yellow]x[white] SLOWLOG RESET  [yellow]r[white] refresh")
+
+	v.flex = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(v.filter, 1, 0, false).
+		AddItem(v.table, 0, 2, true).
+		AddItem(v.details, 0, 1, false).
+		AddItem(help, 1, 0, false)
+
+	v.flex.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if v.filter.HasFocus() {
+			return event
+		}
+
+		switch event.Rune() {
+		case '/':
+			v.host.setFocus(v.filter)
+			return nil
+		case 'o', 'O':
+			v.byDuration = !v.byDuration
+			v.render()
+			return nil
+		case 't', 'T':
+			v.showThresholdDialog()
+			return nil
+		case 'x', 'X':
+			if readOnlyBlocked(v.host, v.redis) {
+				return nil
+			}
+			v.host.confirm("Clear the slow log with SLOWLOG RESET?", v.reset)
+			return nil
+		case 'r', 'R':
+			v.Refresh()
+			return nil
+		}
+		return event
+	})
+}
+
+// GetComponent returns the main component
+func (v *SlowLogView) GetComponent() tview.Primitive {
+	return v.flex
+}
+
+// SetHost sets the host used to show dialogs
+func (v *SlowLogView) SetHost(host *viewHost) {
+	v.host = host
+}
+
+// Refresh reloads the slow log and its settings
+func (v *SlowLogView) Refresh() {
+	v.threshold, _ = v.redis.GetConfigValue("slowlog-log-slower-than")
+	v.maxLen, _ = v.redis.GetConfigValue("slowlog-max-len")
+
+	count := int64(slowLogFetchCount)
+	if n, err := strconv.ParseInt(v.maxLen, 10, 64); err == nil && n > 0 {
+		count = n
+	}
+
+	entries, err := v.redis.GetSlowLog(count)
+	if err != nil {
+		logger.Errorf("[SlowLogView] %v", err)
+		v.entries = nil
+		v.render()
+		v.table.SetCell(1, 0, tview.NewTableCell("ERROR").SetTextColor(tcell.ColorRed))
+		v.table.SetCell(1, 1, tview.NewTableCell(err.Error()).SetTextColor(tcell.ColorRed))
+		return
+	}
+
+	v.entries = entries
+	v.render()
+}
+
+// render fills the table with the filtered and sorted entries
+func (v *SlowLogView) render() {
+	command := strings.ToLower(strings.TrimSpace(v.filter.GetText()))
+
+	v.shown = v.shown[:0]
+	for _, e := range v.entries {
+		if command == "" || strings.Contains(strings.ToLower(e.Command()), command) {
+			v.shown = append(v.shown, e)
+		}
+	}
+	if v.byDuration {
+		sort.SliceStable(v.shown, func(i, j int) bool { return v.shown[i].Duration > v.shown[j].Duration })
+	} else {
+		sort.SliceStable(v.shown, func(i, j int) bool { return v.shown[i].ID > v.shown[j].ID })
+	}
+
+	v.table.Clear()
+	headers := []string{"ID", "Time", "Duration", "Command", "Arguments", "Client", "Name"}
+	for i, header := range headers {
+		if (header == "Duration" && v.byDuration) || (header == "ID" && !v.byDuration) {
+			header += " ↓"
+		}
+		v.table.SetCell(0, i,
+			tview.NewTableCell(header).
+				SetTextColor(tcell.ColorYellow).
+				SetAlign(tview.AlignLeft).
+				SetSelectable(false))
+	}
+
+	for i, e := range v.shown {
+		row := i + 1
+		args := ""
+		if len(e.Args) > 1 {
+			args = redis.QuoteArgs(e.Args[1:])
+		}
+		if len(args) > 80 {
+			args = args[:77] + "..."
+		}
+
+		v.table.SetCell(row, 0, tview.NewTableCell(strconv.FormatInt(e.ID, 10)))
+		v.table.SetCell(row, 1, tview.NewTableCell(e.Time.Format("2006-01-02 15:04:05")))
+		v.table.SetCell(row, 2, tview.NewTableCell(e.Duration.String()).SetAlign(tview.AlignRight).SetTextColor(tcell.ColorOrange))
+		v.table.SetCell(row, 3, tview.NewTableCell(strings.ToUpper(e.Command())).SetTextColor(tcell.ColorGreen))
+		v.table.SetCell(row, 4, tview.NewTableCell(tview.Escape(args)))
+		v.table.SetCell(row, 5, tview.NewTableCell(e.ClientAddr))
+		v.table.SetCell(row, 6, tview.NewTableCell(e.ClientName))
+	}
+
+	v.table.SetTitle(fmt.Sprintf("Slow Log (%d of %d) | slowlog-log-slower-than: %s µs | slowlog-max-len: %s",
+		len(v.shown), len(v.entries), v.threshold, v.maxLen))
+
+	v.details.Clear()
+	if len(v.shown) > 0 {
+		v.table.Select(1, 0)
+		v.showDetails(v.shown[0])
+	}
+}
+
+// showDetails lists every argument of an entry
+func (v *SlowLogView) showDetails(e redis.SlowLogEntry) {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[yellow]#%d[white] %s took [orange]%s[white] from %s", e.ID, e.Time.Format("2006-01-02 15:04:05"), e.Duration, e.ClientAddr)
+	if e.ClientName != "" {
+		fmt.Fprintf(&b, " (%s)", tview.Escape(e.ClientName))
+	}
+	b.WriteString("\n\n")
+	for i, arg := range e.Args {
+		fmt.Fprintf(&b, "%d) %s\n", i+1, tview.Escape(redis.QuoteArg(arg)))
+	}
+	v.details.SetText(b.String()).ScrollToBeginning()
+}
+
+// reset clears the slow log
+func (v *SlowLogView) reset() {
+	if err := v.redis.ResetSlowLog(); err != nil {
+		v.host.showMessage(err.Error())
+		return
+	}
+	logger.Info("[SlowLogView] Slow log reset")
+	v.Refresh()
+}
+
+// showThresholdDialog changes slowlog-log-slower-than and slowlog-max-len with CONFIG SET
+func (v *SlowLogView) showThresholdDialog() {
+	if readOnlyBlocked(v.host, v.redis) {
+		return
+	}
+	const name = "slowlog_threshold"
+
+	threshold := v.threshold
+	maxLen := v.maxLen
+
+	form := v.host.newDialogForm(name, "Slow log settings (CONFIG SET)")
+	form.AddInputField("Log slower than (µs)", threshold, 12, nil, func(text string) {
+		threshold = strings.TrimSpace(text)
+	})
+	form.AddInputField("Max entries", maxLen, 12, tview.InputFieldInteger, func(text string) {
+		maxLen = strings.TrimSpace(text)
+	})
+	form.AddTextView("", "0 logs every command, -1 disables the slow log", 50, 1, true, false)
+	form.AddButton("Apply", func() {
+		v.host.closeDialog(name)
+		if threshold != v.threshold {
+			if err := v.redis.SetConfigValue("slowlog-log-slower-than", threshold); err != nil {
+				v.host.showMessage(err.Error())
+				return
+			}
+		}
+		if maxLen != v.maxLen {
+			if err := v.redis.SetConfigValue("slowlog-max-len", maxLen); err != nil {
+				v.host.showMessage(err.Error())
+				return
+			}
+		}
+		v.Refresh()
+	})
+	form.AddButton("Cancel", func() {
+		v.host.closeDialog(name)
+	})
+
+	v.host.showDialog(name, form, 60, 11)
+}
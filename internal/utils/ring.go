@@ -0,0 +1,65 @@
+package utils
+
+// Ring is a fixed-size buffer that overwrites its oldest item when full
+type Ring[T any] struct {
+	items []T
+	start int
+	size  int
+}
+
+// NewRing creates a ring buffer holding at most capacity items
+func NewRing[T any](capacity int) *Ring[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Ring[T]{items: make([]T, capacity)}
+}
+
+// Push appends an item, dropping the oldest one when the ring is full
+func (r *Ring[T]) Push(item T) {
+	if r.size < len(r.items) {
+		r.items[(r.start+r.size)%len(r.items)] = item
+		r.size++
+		return
+	}
+	r.items[r.start] = item
+	r.start = (r.start + 1) % len(r.items)
+}
+
+// Items returns a copy of the items, oldest first
+func (r *Ring[T]) Items() []T {
+	out := make([]T, r.size)
+	for i := 0; i < r.size; i++ {
+		out[i] = r.items[(r.start+i)%len(r.items)]
+	}
+	return out
+}
+
+// Last returns the newest item
+func (r *Ring[T]) Last() (T, bool) {
+	var zero T
+	if r.size == 0 {
+		return zero, false
+	}
+	return r.items[(r.start+r.size-1)%len(r.items)], true
+}
+
+// Len returns the number of items held
+func (r *Ring[T]) Len() int {
+	return r.size
+}
+
+// Cap returns the maximum number of items
+func (r *Ring[T]) Cap() int {
+	return len(r.items)
+}
+
+// Clear removes all items
+func (r *Ring[T]) Clear() {
+	var zero T
+	for i := range r.items {
+		r.items[i] = zero
+	}
+	r.start = 0
+	r.size = 0
+}